@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jpequegn/benchflow/internal/comparator"
 	"github.com/jpequegn/benchflow/internal/parser"
 )
 
@@ -42,8 +44,11 @@ func (a *DefaultAggregator) Aggregate(suite *parser.BenchmarkSuite) (*Aggregated
 		aggResult := &AggregatedResult{
 			Name:       result.Name,
 			Language:   result.Language,
+			Package:    result.Metadata[parser.MetadataPackage],
+			Group:      result.Metadata[parser.MetadataGroup],
+			Params:     resultParams(result.Metadata),
 			Mean:       result.Time,
-			Median:     result.Time, // For single iteration, mean = median
+			Median:     result.Time, // Without samples, mean = median
 			Min:        result.Time,
 			Max:        result.Time,
 			StdDev:     result.StdDev,
@@ -51,6 +56,44 @@ func (a *DefaultAggregator) Aggregate(suite *parser.BenchmarkSuite) (*Aggregated
 			Timestamp:  suite.Timestamp,
 		}
 
+		// Compute real statistics when raw samples are available, otherwise
+		// use the summary statistics the parser reported
+		if len(result.Samples) > 0 {
+			applySampleStatistics(aggResult, result.Samples)
+		} else {
+			applyReportedStatistics(aggResult, result.Metadata)
+		}
+
+		if result.Memory != nil {
+			bytesPerOp := result.Memory.BytesPerOp
+			allocsPerOp := result.Memory.AllocsPerOp
+			aggResult.BytesPerOp = &bytesPerOp
+			aggResult.AllocsPerOp = &allocsPerOp
+		}
+
+		// Time and memory have dedicated fields; everything else is kept as named metrics.
+		// Memory counts a parser reported as metrics (e.g., BenchmarkDotNet's allocated
+		// bytes without an allocation count) fill the memory fields they measure.
+		for _, metric := range result.AllMetrics() {
+			switch metric.Name {
+			case parser.MetricTimePerOp:
+				continue
+			case parser.MetricBytesPerOp:
+				if aggResult.BytesPerOp == nil {
+					bytesPerOp := int64(math.Round(metric.Value))
+					aggResult.BytesPerOp = &bytesPerOp
+				}
+				continue
+			case parser.MetricAllocsPerOp:
+				if aggResult.AllocsPerOp == nil {
+					allocsPerOp := int64(math.Round(metric.Value))
+					aggResult.AllocsPerOp = &allocsPerOp
+				}
+				continue
+			}
+			aggResult.Metrics = append(aggResult.Metrics, aggregateMetric(metric))
+		}
+
 		aggregated.Results = append(aggregated.Results, aggResult)
 	}
 
@@ -164,9 +207,94 @@ func (a *DefaultAggregator) compareResults(baseline, current *AggregatedResult,
 		}
 	}
 
+	// Compare memory and named metrics present on both sides; a metric that got
+	// worse beyond its threshold makes the benchmark a regression
+	baselineMetrics := baseline.comparableMetrics()
+	for _, currentMetric := range current.comparableMetrics() {
+		var baselineMetric *AggregatedMetric
+		for _, m := range baselineMetrics {
+			if m.Name == currentMetric.Name {
+				baselineMetric = m
+				break
+			}
+		}
+		if baselineMetric == nil || baselineMetric.Unit != currentMetric.Unit {
+			continue
+		}
+
+		mc := compareMetric(baselineMetric, currentMetric, metricThresholdPercent(currentMetric, threshold))
+		comp.Metrics = append(comp.Metrics, mc)
+		if mc.Regression {
+			comp.Regression = true
+			comp.Improvement = false
+		}
+	}
+
 	return comp
 }
 
+// comparableMetrics returns the memory metrics followed by the named metrics of a result
+func (r *AggregatedResult) comparableMetrics() []*AggregatedMetric {
+	metrics := make([]*AggregatedMetric, 0, len(r.Metrics)+2)
+	for _, memory := range []struct {
+		name, unit string
+		value      *int64
+	}{
+		{parser.MetricBytesPerOp, "B/op", r.BytesPerOp},
+		{parser.MetricAllocsPerOp, "allocs/op", r.AllocsPerOp},
+	} {
+		if memory.value == nil {
+			continue
+		}
+		value := float64(*memory.value)
+		metrics = append(metrics, &AggregatedMetric{
+			Name: memory.name, Unit: memory.unit, Deterministic: true,
+			Mean: value, Median: value, Min: value, Max: value, Count: 1,
+		})
+	}
+	return append(metrics, r.Metrics...)
+}
+
+// metricThresholdPercent returns the percentage a metric may change before it counts:
+// the comparator's per-metric default (allocations may not grow at all), the tight
+// deterministic default for other deterministic metrics, or threshold for the rest
+func metricThresholdPercent(metric *AggregatedMetric, threshold float64) float64 {
+	if ratio, ok := comparator.DefaultMetricThresholds()[metric.Name]; ok {
+		return (ratio - 1) * 100
+	}
+	if metric.Deterministic {
+		return (comparator.DeterministicMetricThreshold - 1) * 100
+	}
+	return threshold
+}
+
+// compareMetric compares the means of a named metric, honouring its better direction
+func compareMetric(baseline, current *AggregatedMetric, threshold float64) *MetricComparison {
+	mc := &MetricComparison{
+		Name:           current.Name,
+		Unit:           current.Unit,
+		HigherIsBetter: current.HigherIsBetter,
+		Baseline:       baseline.Mean,
+		Current:        current.Mean,
+		Threshold:      threshold,
+	}
+
+	if baseline.Mean != 0 {
+		mc.DeltaPercent = (current.Mean - baseline.Mean) / math.Abs(baseline.Mean) * 100
+	}
+
+	if math.Abs(mc.DeltaPercent) > threshold {
+		worse := mc.DeltaPercent > 0
+		if mc.HigherIsBetter {
+			worse = !worse
+		}
+		mc.Regression = worse
+		mc.Improvement = !worse
+	}
+
+	return mc
+}
+
 // Export exports aggregated results to the specified format
 func (a *DefaultAggregator) Export(suite *AggregatedSuite, format ExportFormat) ([]byte, error) {
 	if suite == nil {
@@ -198,7 +326,7 @@ func (a *DefaultAggregator) exportCSV(suite *AggregatedSuite) ([]byte, error) {
 	writer := csv.NewWriter(&buf)
 
 	// Write header
-	header := []string{"Name", "Language", "Mean (ns)", "Median (ns)", "Min (ns)", "Max (ns)", "StdDev (ns)", "Iterations"}
+	header := []string{"Name", "Language", "Mean (ns)", "Median (ns)", "Min (ns)", "Max (ns)", "StdDev (ns)", "Iterations", "Bytes/op", "Allocs/op"}
 	if err := writer.Write(header); err != nil {
 		return nil, fmt.Errorf("failed to write CSV header: %w", err)
 	}
@@ -214,6 +342,8 @@ func (a *DefaultAggregator) exportCSV(suite *AggregatedSuite) ([]byte, error) {
 			fmt.Sprintf("%d", result.Max.Nanoseconds()),
 			fmt.Sprintf("%d", result.StdDev.Nanoseconds()),
 			fmt.Sprintf("%d", result.Iterations),
+			formatOptionalInt(result.BytesPerOp),
+			formatOptionalInt(result.AllocsPerOp),
 		}
 		if err := writer.Write(row); err != nil {
 			return nil, fmt.Errorf("failed to write CSV row: %w", err)
@@ -228,6 +358,130 @@ func (a *DefaultAggregator) exportCSV(suite *AggregatedSuite) ([]byte, error) {
 	return []byte(buf.String()), nil
 }
 
+// aggregateMetric computes statistics for a named metric from its samples,
+// or from its single value when no samples are available
+func aggregateMetric(metric parser.Metric) *AggregatedMetric {
+	agg := &AggregatedMetric{
+		Name:           metric.Name,
+		Unit:           metric.Unit,
+		HigherIsBetter: metric.HigherIsBetter,
+		Deterministic:  metric.Deterministic,
+		Mean:           metric.Value,
+		Median:         metric.Value,
+		Min:            metric.Value,
+		Max:            metric.Value,
+		Count:          1,
+	}
+
+	if len(metric.Samples) == 0 {
+		return agg
+	}
+
+	sorted := make([]float64, len(metric.Samples))
+	copy(sorted, metric.Samples)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	n := len(sorted)
+	agg.Count = n
+	agg.Mean = sum / float64(n)
+	agg.Min = sorted[0]
+	agg.Max = sorted[n-1]
+	if n%2 == 0 {
+		agg.Median = (sorted[n/2-1] + sorted[n/2]) / 2
+	} else {
+		agg.Median = sorted[n/2]
+	}
+
+	if n > 1 {
+		var sumSquares float64
+		for _, v := range sorted {
+			sumSquares += (v - agg.Mean) * (v - agg.Mean)
+		}
+		agg.StdDev = math.Sqrt(sumSquares / float64(n-1))
+	}
+
+	return agg
+}
+
+// formatOptionalInt formats an optional integer, leaving the CSV cell empty when unset
+func formatOptionalInt(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", *v)
+}
+
+// applySampleStatistics fills mean, median, min, max and standard deviation from raw samples
+func applySampleStatistics(result *AggregatedResult, samples []time.Duration) {
+	result.Samples = samples
+	result.Mean, result.Median, _ = CalculateStatistics(samples)
+	result.StdDev = sampleStdDev(samples, result.Mean)
+
+	result.Min = samples[0]
+	result.Max = samples[0]
+	for _, s := range samples[1:] {
+		if s < result.Min {
+			result.Min = s
+		}
+		if s > result.Max {
+			result.Max = s
+		}
+	}
+}
+
+// applyReportedStatistics fills median, min and max from the summary statistics
+// a parser recorded in metadata, keeping the reported time where one is missing
+func applyReportedStatistics(result *AggregatedResult, metadata map[string]string) {
+	for key, field := range map[string]*time.Duration{
+		parser.MetadataMedianNs: &result.Median,
+		parser.MetadataMinNs:    &result.Min,
+		parser.MetadataMaxNs:    &result.Max,
+	} {
+		ns, err := strconv.ParseFloat(metadata[key], 64)
+		if err != nil || ns < 0 || math.IsInf(ns, 0) {
+			continue
+		}
+		*field = time.Duration(math.Round(ns))
+	}
+}
+
+// resultParams returns the benchmark parameters recorded as "param.<name>" metadata,
+// or nil when the benchmark is not parameterized
+func resultParams(metadata map[string]string) map[string]string {
+	var params map[string]string
+	for key, value := range metadata {
+		name, ok := strings.CutPrefix(key, parser.MetadataParamPrefix)
+		if !ok || name == "" {
+			continue
+		}
+		if params == nil {
+			params = make(map[string]string)
+		}
+		params[name] = value
+	}
+	return params
+}
+
+// sampleStdDev calculates the sample standard deviation (n-1) of durations around mean
+func sampleStdDev(samples []time.Duration, mean time.Duration) time.Duration {
+	if len(samples) < 2 {
+		return 0
+	}
+
+	var variance float64
+	for _, s := range samples {
+		diff := float64(s - mean)
+		variance += diff * diff
+	}
+	variance /= float64(len(samples) - 1)
+
+	return time.Duration(math.Sqrt(variance))
+}
+
 // CalculateStatistics calculates statistical measures for a set of durations
 func CalculateStatistics(durations []time.Duration) (mean, median, stdDev time.Duration) {
 	if len(durations) == 0 {
@@ -3,6 +3,7 @@ package aggregator
 import (
 	"encoding/csv"
 	"encoding/json"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -57,6 +58,305 @@ func TestAggregator_Aggregate_Success(t *testing.T) {
 	}
 }
 
+func TestAggregator_Aggregate_WithSamples(t *testing.T) {
+	agg := NewAggregator()
+
+	suite := &parser.BenchmarkSuite{
+		Language:  "go",
+		Timestamp: time.Now(),
+		Results: []*parser.BenchmarkResult{
+			{
+				Name:       "BenchmarkSort-8",
+				Language:   "go",
+				Time:       110 * time.Nanosecond,
+				Iterations: 5000,
+				Samples: []time.Duration{
+					100 * time.Nanosecond,
+					90 * time.Nanosecond,
+					130 * time.Nanosecond,
+					110 * time.Nanosecond,
+					120 * time.Nanosecond,
+				},
+			},
+		},
+	}
+
+	result, err := agg.Aggregate(suite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := result.Results[0]
+	if r.Mean != 110*time.Nanosecond {
+		t.Errorf("expected mean 110ns, got %v", r.Mean)
+	}
+	if r.Median != 110*time.Nanosecond {
+		t.Errorf("expected median 110ns, got %v", r.Median)
+	}
+	if r.Min != 90*time.Nanosecond {
+		t.Errorf("expected min 90ns, got %v", r.Min)
+	}
+	if r.Max != 130*time.Nanosecond {
+		t.Errorf("expected max 130ns, got %v", r.Max)
+	}
+	// Sample stddev of 90..130 in steps of 10 is sqrt(250) ≈ 15.81ns
+	if r.StdDev != 15*time.Nanosecond {
+		t.Errorf("expected stddev 15ns, got %v", r.StdDev)
+	}
+	if len(r.Samples) != 5 {
+		t.Errorf("expected 5 samples, got %d", len(r.Samples))
+	}
+}
+
+func TestAggregator_Aggregate_ReportedStatistics(t *testing.T) {
+	agg := NewAggregator()
+
+	suite := &parser.BenchmarkSuite{
+		Language:  "python",
+		Timestamp: time.Now(),
+		Results: []*parser.BenchmarkResult{
+			{
+				Name:       "test_sort[100-random]",
+				Language:   "python",
+				Time:       4520 * time.Nanosecond,
+				StdDev:     610 * time.Nanosecond,
+				Iterations: 21345,
+				Metadata: map[string]string{
+					parser.MetadataMedianNs: "4400",
+					parser.MetadataMinNs:    "4100",
+					parser.MetadataMaxNs:    "26300",
+					parser.MetadataGroup:    "sort",
+					"param.size":            "100",
+					"param.order":           "random",
+				},
+			},
+			{
+				Name:     "test_parse",
+				Language: "python",
+				Time:     853 * time.Microsecond,
+			},
+		},
+	}
+
+	result, err := agg.Aggregate(suite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := result.Results[0]
+	if r.Mean != 4520*time.Nanosecond {
+		t.Errorf("expected mean 4.52µs, got %v", r.Mean)
+	}
+	if r.Median != 4400*time.Nanosecond {
+		t.Errorf("expected median 4.4µs, got %v", r.Median)
+	}
+	if r.Min != 4100*time.Nanosecond {
+		t.Errorf("expected min 4.1µs, got %v", r.Min)
+	}
+	if r.Max != 26300*time.Nanosecond {
+		t.Errorf("expected max 26.3µs, got %v", r.Max)
+	}
+	if r.StdDev != 610*time.Nanosecond {
+		t.Errorf("expected stddev 610ns, got %v", r.StdDev)
+	}
+	if r.Group != "sort" {
+		t.Errorf("expected group sort, got %q", r.Group)
+	}
+	if len(r.Params) != 2 || r.Params["size"] != "100" || r.Params["order"] != "random" {
+		t.Errorf("expected params size=100 and order=random, got %v", r.Params)
+	}
+
+	// Without reported statistics, median, min and max equal the reported time
+	plain := result.Results[1]
+	if plain.Median != plain.Mean || plain.Min != plain.Mean || plain.Max != plain.Mean {
+		t.Errorf("expected median, min and max to equal the mean %v, got %v, %v, %v",
+			plain.Mean, plain.Median, plain.Min, plain.Max)
+	}
+	if plain.Group != "" || plain.Params != nil {
+		t.Errorf("expected no group or params, got %q and %v", plain.Group, plain.Params)
+	}
+}
+
+func TestAggregator_Aggregate_WithMemory(t *testing.T) {
+	agg := NewAggregator()
+
+	suite := &parser.BenchmarkSuite{
+		Language: "go",
+		Results: []*parser.BenchmarkResult{
+			{Name: "BenchmarkEncode-8", Language: "go", Time: 100 * time.Nanosecond, Memory: &parser.MemoryStats{BytesPerOp: 256, AllocsPerOp: 3}},
+			{Name: "BenchmarkNoop-8", Language: "go", Time: 1 * time.Nanosecond},
+		},
+	}
+
+	result, err := agg.Aggregate(suite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	encode := result.Results[0]
+	if encode.BytesPerOp == nil || *encode.BytesPerOp != 256 || encode.AllocsPerOp == nil || *encode.AllocsPerOp != 3 {
+		t.Errorf("expected 256 B/op and 3 allocs/op, got %v / %v", encode.BytesPerOp, encode.AllocsPerOp)
+	}
+	if result.Results[1].BytesPerOp != nil || result.Results[1].AllocsPerOp != nil {
+		t.Error("expected no memory metrics for a benchmark without them")
+	}
+	if !result.HasMemoryMetrics() {
+		t.Error("expected suite to report memory metrics")
+	}
+
+	data, err := agg.Export(result, FormatCSV)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	header := records[0]
+	if header[len(header)-2] != "Bytes/op" || header[len(header)-1] != "Allocs/op" {
+		t.Errorf("expected memory columns at the end of the header, got %v", header)
+	}
+	if got := records[1][len(header)-1]; got != "3" {
+		t.Errorf("expected 3 allocs/op in CSV, got %q", got)
+	}
+	if got := records[2][len(header)-1]; got != "" {
+		t.Errorf("expected empty allocs/op for unmeasured benchmark, got %q", got)
+	}
+}
+
+func TestAggregator_Aggregate_BenchmarkDotNetMemory(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/csharp/Md5VsSha256-report-full-compressed.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	suite, err := parser.NewBenchmarkDotNetParser().Parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	result, err := NewAggregator().Aggregate(suite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sha := result.Results[0]
+	if sha.BytesPerOp == nil || *sha.BytesPerOp != 112 {
+		t.Errorf("expected 112 B/op from MemoryDiagnoser, got %v", sha.BytesPerOp)
+	}
+	if sha.AllocsPerOp != nil {
+		t.Errorf("expected allocs/op to stay unmeasured, got %d", *sha.AllocsPerOp)
+	}
+	if sha.Metric("bytes_per_op") != nil || sha.Metric("gen0_collections") == nil {
+		t.Errorf("expected bytes in BytesPerOp and GC counts as named metrics, got %+v", sha.Metrics)
+	}
+	if !result.HasMemoryMetrics() {
+		t.Error("expected suite to report memory metrics")
+	}
+}
+
+func TestAggregator_Aggregate_AsvParams(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/python/asv_results.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	suite, err := parser.NewAsvParser().Parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	result, err := NewAggregator().Aggregate(suite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	merge := result.Results[2]
+	if merge.Group != "benchmarks.SortSuite.time_sort" {
+		t.Errorf("expected group benchmarks.SortSuite.time_sort, got %q", merge.Group)
+	}
+	if len(merge.Params) != 2 || merge.Params["0"] != "100" || merge.Params["1"] != "'merge'" {
+		t.Errorf("expected positional params 0=100 and 1='merge', got %v", merge.Params)
+	}
+}
+
+func TestAggregator_Aggregate_NamedMetrics(t *testing.T) {
+	agg := NewAggregator()
+
+	suite := &parser.BenchmarkSuite{
+		Results: []*parser.BenchmarkResult{
+			{
+				Name:       "bench_parse",
+				Language:   "rust",
+				Time:       100 * time.Nanosecond,
+				Memory:     &parser.MemoryStats{BytesPerOp: 16, AllocsPerOp: 1},
+				Throughput: &parser.Throughput{Value: 830, Unit: "MB/s"},
+				Metrics: []parser.Metric{
+					{Name: "rss", Unit: "MB", Value: 12, Samples: []float64{10, 12, 14, 11}},
+				},
+			},
+		},
+	}
+
+	result, err := agg.Aggregate(suite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := result.Results[0]
+	if len(r.Metrics) != 2 {
+		t.Fatalf("expected throughput and rss as named metrics, got %d", len(r.Metrics))
+	}
+
+	throughput := r.Metric("throughput")
+	if throughput == nil || !throughput.HigherIsBetter || throughput.Mean != 830 || throughput.Count != 1 {
+		t.Errorf("unexpected throughput metric: %+v", throughput)
+	}
+
+	rss := r.Metric("rss")
+	if rss == nil {
+		t.Fatal("expected rss metric")
+	}
+	if rss.Mean != 11.75 || rss.Median != 11.5 || rss.Min != 10 || rss.Max != 14 || rss.Count != 4 {
+		t.Errorf("unexpected rss statistics: %+v", rss)
+	}
+	if rss.StdDev < 1.70 || rss.StdDev > 1.71 {
+		t.Errorf("expected rss stddev ≈ 1.708, got %v", rss.StdDev)
+	}
+
+	if r.Metric("bytes_per_op") != nil {
+		t.Error("expected memory to stay in its dedicated fields")
+	}
+}
+
+func TestAggregator_Aggregate_DeterministicMetrics(t *testing.T) {
+	suite, err := parser.NewIaiCallgrindParser().Parse([]byte("bench_fib short:10\n  Instructions:  10000\n  L1 Hits:  9000\n"))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	agg := NewAggregator()
+	result, err := agg.Aggregate(suite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hits := result.Results[0].Metric("l1_hits")
+	if hits == nil || !hits.Deterministic {
+		t.Fatalf("expected deterministic l1_hits metric, got %+v", hits)
+	}
+
+	data, err := agg.Export(result, FormatJSON)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var exported AggregatedSuite
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatalf("failed to decode export: %v", err)
+	}
+	if m := exported.Results[0].Metric("l1_hits"); m == nil || !m.Deterministic {
+		t.Errorf("expected exported l1_hits to stay deterministic, got %+v", m)
+	}
+}
+
 func TestAggregator_Aggregate_NilSuite(t *testing.T) {
 	agg := NewAggregator()
 
@@ -170,6 +470,82 @@ func TestAggregator_Compare_WithinThreshold(t *testing.T) {
 	}
 }
 
+func TestAggregator_Compare_NamedMetrics(t *testing.T) {
+	agg := NewAggregator()
+
+	result := func(mbPerSec, instructions float64) *AggregatedResult {
+		return &AggregatedResult{
+			Name: "bench_parse",
+			Mean: 100 * time.Nanosecond,
+			Metrics: []*AggregatedMetric{
+				{Name: "throughput", Unit: "MB/s", HigherIsBetter: true, Mean: mbPerSec},
+				{Name: "instructions", Unit: "instr", Mean: instructions},
+			},
+		}
+	}
+
+	baseline := &AggregatedSuite{Results: []*AggregatedResult{result(1000, 5000)}}
+	current := &AggregatedSuite{Results: []*AggregatedResult{result(900, 4000)}}
+
+	comparison, err := agg.Compare(baseline, current, 5.0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	comp := comparison.Comparisons[0]
+	if len(comp.Metrics) != 2 {
+		t.Fatalf("expected 2 metric comparisons, got %d", len(comp.Metrics))
+	}
+
+	throughput, instructions := comp.Metrics[0], comp.Metrics[1]
+	if !throughput.Regression || throughput.DeltaPercent != -10 {
+		t.Errorf("expected a 10%% throughput drop to regress, got %+v", throughput)
+	}
+	if !instructions.Improvement || instructions.DeltaPercent != -20 {
+		t.Errorf("expected 20%% fewer instructions to improve, got %+v", instructions)
+	}
+	if !comp.Regression || comparison.RegressionCount != 1 {
+		t.Error("expected a metric regression to mark the benchmark as regressed despite unchanged time")
+	}
+}
+
+func TestAggregator_Compare_MemoryThresholds(t *testing.T) {
+	agg := NewAggregator()
+
+	result := func(bytesPerOp, allocsPerOp int64) *AggregatedResult {
+		return &AggregatedResult{
+			Name:        "BenchmarkEncode-8",
+			Mean:        100 * time.Nanosecond,
+			BytesPerOp:  &bytesPerOp,
+			AllocsPerOp: &allocsPerOp,
+		}
+	}
+
+	baseline := &AggregatedSuite{Results: []*AggregatedResult{result(1000, 10)}}
+	current := &AggregatedSuite{Results: []*AggregatedResult{result(1040, 11)}}
+
+	comparison, err := agg.Compare(baseline, current, 20.0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	comp := comparison.Comparisons[0]
+	if len(comp.Metrics) != 2 {
+		t.Fatalf("expected bytes and allocs comparisons, got %d", len(comp.Metrics))
+	}
+
+	bytesPerOp, allocsPerOp := comp.Metrics[0], comp.Metrics[1]
+	if bytesPerOp.Name != "bytes_per_op" || bytesPerOp.Regression || bytesPerOp.Threshold > 5.0001 {
+		t.Errorf("expected 4%% more bytes to stay within the 5%% bytes threshold, got %+v", bytesPerOp)
+	}
+	if allocsPerOp.Name != "allocs_per_op" || !allocsPerOp.Regression || allocsPerOp.Threshold != 0 {
+		t.Errorf("expected one more allocation to regress despite the 20%% threshold, got %+v", allocsPerOp)
+	}
+	if !comp.Regression || comparison.RegressionCount != 1 {
+		t.Error("expected an allocation increase to mark the benchmark as regressed with unchanged time")
+	}
+}
+
 func TestAggregator_Compare_MissingBaseline(t *testing.T) {
 	agg := NewAggregator()
 
@@ -60,6 +60,21 @@
 //   - **Max**: Slowest observed time
 //   - **StdDev**: Standard deviation (measure of variance)
 //
+// When a result carries raw samples, all five are computed from the samples
+// (StdDev uses the n-1 sample estimator) and the samples are kept on the
+// AggregatedResult. Without samples, Mean is the reported time and StdDev is
+// taken from the parser; Median, Min and Max come from the summary statistics
+// the parser reported (parser.MetadataMedianNs, MetadataMinNs, MetadataMaxNs,
+// e.g. from pytest-benchmark or hyperfine) and otherwise equal the reported time.
+//
+// The benchmark group and parameters parsers record as labels
+// (parser.MetadataGroup and "param.<name>" metadata) are kept as
+// AggregatedResult.Group and AggregatedResult.Params.
+//
+// Throughput and any additional named metrics (parser.Metric, e.g. instructions
+// or RSS) are aggregated the same way into AggregatedResult.Metrics, keeping
+// their unit and better direction. Time and memory keep their dedicated fields.
+//
 // For suites, it also calculates:
 //
 //   - Total benchmarks count
@@ -81,6 +96,14 @@
 //   - DeltaPercent = 20%
 //   - Regression = true (20% > 5% and positive)
 //
+// Memory (bytes and allocations per operation) and named metrics present on
+// both sides in the same unit are compared by mean, in their better direction:
+// a throughput drop or an allocation increase beyond the metric's threshold is
+// a regression, and marks the whole benchmark as regressed even when time is
+// unchanged. Thresholds follow the comparator's defaults: allocations may not
+// grow at all, bytes by 5%, deterministic counts such as instructions by 0.1%,
+// and every other metric by the time threshold.
+//
 // # Export Formats
 //
 // ## JSON Format
@@ -95,6 +118,8 @@
 //	      "mean": 1234000,
 //	      "median": 1200000,
 //	      "stddev": 56000,
+//	      "bytes_per_op": 512,   // omitted when not measured
+//	      "allocs_per_op": 4,
 //	      ...
 //	    }
 //	  ],
@@ -106,8 +131,11 @@
 //
 // Exports results as comma-separated values for spreadsheet analysis:
 //
-//	Name,Language,Mean (ns),Median (ns),Min (ns),Max (ns),StdDev (ns),Iterations
-//	bench_sort,rust,1234,1200,1100,1300,56,1000
+//	Name,Language,Mean (ns),Median (ns),Min (ns),Max (ns),StdDev (ns),Iterations,Bytes/op,Allocs/op
+//	bench_sort,rust,1234,1200,1100,1300,56,1000,,
+//	BenchmarkEncode-8,go,850,840,800,900,20,2000000,512,4
+//
+// Bytes/op and Allocs/op are empty when the benchmark did not report memory metrics.
 //
 // # Thread Safety
 //
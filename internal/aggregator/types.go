@@ -8,15 +8,46 @@ import (
 
 // AggregatedResult represents aggregated statistics for a single benchmark
 type AggregatedResult struct {
-	Name       string        `json:"name"`
-	Language   string        `json:"language"`
-	Mean       time.Duration `json:"mean"`
-	Median     time.Duration `json:"median"`
-	Min        time.Duration `json:"min"`
-	Max        time.Duration `json:"max"`
-	StdDev     time.Duration `json:"stddev"`
-	Iterations int64         `json:"iterations"`
-	Timestamp  time.Time     `json:"timestamp"`
+	Name        string              `json:"name"`
+	Language    string              `json:"language"`
+	Package     string              `json:"package,omitempty"` // package or module, when the parser reports it
+	Group       string              `json:"group,omitempty"`   // benchmark group, when the parser reports it
+	Params      map[string]string   `json:"params,omitempty"`  // benchmark parameters, when parameterized
+	Mean        time.Duration       `json:"mean"`
+	Median      time.Duration       `json:"median"`
+	Min         time.Duration       `json:"min"`
+	Max         time.Duration       `json:"max"`
+	StdDev      time.Duration       `json:"stddev"`
+	Iterations  int64               `json:"iterations"`
+	BytesPerOp  *int64              `json:"bytes_per_op,omitempty"`  // nil when not measured
+	AllocsPerOp *int64              `json:"allocs_per_op,omitempty"` // nil when not measured
+	Metrics     []*AggregatedMetric `json:"metrics,omitempty"`       // throughput and additional named metrics
+	Samples     []time.Duration     `json:"samples,omitempty"`
+	Timestamp   time.Time           `json:"timestamp"`
+}
+
+// AggregatedMetric represents aggregated statistics for a named metric
+type AggregatedMetric struct {
+	Name           string  `json:"name"`
+	Unit           string  `json:"unit"`
+	HigherIsBetter bool    `json:"higher_is_better,omitempty"`
+	Deterministic  bool    `json:"deterministic,omitempty"` // count without measurement noise
+	Mean           float64 `json:"mean"`
+	Median         float64 `json:"median"`
+	Min            float64 `json:"min"`
+	Max            float64 `json:"max"`
+	StdDev         float64 `json:"stddev"`
+	Count          int     `json:"count"` // number of values aggregated
+}
+
+// Metric returns the named metric, or nil when the result does not have it
+func (r *AggregatedResult) Metric(name string) *AggregatedMetric {
+	for _, m := range r.Metrics {
+		if m.Name == name {
+			return m
+		}
+	}
+	return nil
 }
 
 // AggregatedSuite represents a collection of aggregated benchmark results
@@ -28,6 +59,36 @@ type AggregatedSuite struct {
 	Stats     *SuiteStats         `json:"stats"`
 }
 
+// HasMemoryMetrics reports whether any result in the suite has memory metrics
+func (s *AggregatedSuite) HasMemoryMetrics() bool {
+	for _, r := range s.Results {
+		if r.BytesPerOp != nil || r.AllocsPerOp != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPackages reports whether any result in the suite records its package
+func (s *AggregatedSuite) HasPackages() bool {
+	for _, r := range s.Results {
+		if r.Package != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEnvironment reports whether the suite metadata describes the environment it ran in
+func (s *AggregatedSuite) HasEnvironment() bool {
+	for _, key := range []string{parser.MetadataGOOS, parser.MetadataGOARCH, parser.MetadataCPU, parser.MetadataPackage} {
+		if s.Metadata[key] != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // SuiteStats contains overall statistics for a suite
 type SuiteStats struct {
 	TotalBenchmarks int           `json:"total_benchmarks"`
@@ -40,13 +101,27 @@ type SuiteStats struct {
 
 // Comparison represents a comparison between two benchmark runs
 type Comparison struct {
-	Name         string            `json:"name"`
-	Baseline     *AggregatedResult `json:"baseline"`
-	Current      *AggregatedResult `json:"current"`
-	Delta        time.Duration     `json:"delta"`
-	DeltaPercent float64           `json:"delta_percent"`
-	Regression   bool              `json:"regression"`
-	Improvement  bool              `json:"improvement"`
+	Name         string              `json:"name"`
+	Baseline     *AggregatedResult   `json:"baseline"`
+	Current      *AggregatedResult   `json:"current"`
+	Delta        time.Duration       `json:"delta"`
+	DeltaPercent float64             `json:"delta_percent"`
+	Regression   bool                `json:"regression"`
+	Improvement  bool                `json:"improvement"`
+	Metrics      []*MetricComparison `json:"metrics,omitempty"`
+}
+
+// MetricComparison represents the change of a named metric between two runs
+type MetricComparison struct {
+	Name           string  `json:"name"`
+	Unit           string  `json:"unit"`
+	HigherIsBetter bool    `json:"higher_is_better,omitempty"`
+	Baseline       float64 `json:"baseline"`
+	Current        float64 `json:"current"`
+	DeltaPercent   float64 `json:"delta_percent"`
+	Threshold      float64 `json:"threshold"` // percentage the metric may change before it counts
+	Regression     bool    `json:"regression"`
+	Improvement    bool    `json:"improvement"`
 }
 
 // ComparisonSuite represents a collection of benchmark comparisons
@@ -22,7 +22,12 @@ Supports JSON and CSV input formats.
 Example:
   benchflow compare --baseline baseline.json --current current.json
   benchflow compare --baseline baseline.json --current current.json --format html --output report.html
-  benchflow compare -b main.json -c feature.json -f markdown`,
+  benchflow compare -b main.json -c feature.json -f markdown
+  benchflow compare -b main.json -c feature.json --test mannwhitney
+  benchflow compare -b main.json -c feature.json --correction bh
+  benchflow compare -b main.json -c feature.json --policy threshold
+  benchflow compare -b main.json -c feature.json --renames renames.txt
+  benchflow compare -b main.json -c feature.json --metric-threshold bytes_per_op=1.10`,
 	RunE: compareBenchmarks,
 }
 
@@ -34,6 +39,14 @@ func init() {
 	compareCmd.Flags().StringP("current", "c", "", "path to current benchmark results (JSON or CSV) (required)")
 	compareCmd.Flags().Float64P("threshold", "t", 1.05, "regression threshold multiplier (default: 1.05 = 5% slower)")
 	compareCmd.Flags().Float64P("confidence", "C", 0.95, "statistical confidence level (default: 0.95 = 95%)")
+	compareCmd.Flags().String("test", "welch", "statistical test: welch or mannwhitney (mannwhitney requires raw samples)")
+	compareCmd.Flags().String("renames", "", "path to a rename mapping file with 'old_name -> new_name' lines")
+	compareCmd.Flags().String("policy", "both", "regression policy: threshold, significance, or both")
+	compareCmd.Flags().StringSlice("metric-threshold", nil, "per-metric regression threshold as metric=ratio, repeatable (e.g. allocs_per_op=1.0 for no increase, instructions=1.01)")
+	compareCmd.Flags().Float64("min-effect-size", 0, "minimum |Cohen's d| required to flag a regression (0 = disabled)")
+	compareCmd.Flags().String("correction", "none", "multiple-comparison correction: none, bh (Benjamini–Hochberg), or holm (Holm–Bonferroni)")
+	compareCmd.Flags().Int("bootstrap-resamples", comparator.DefaultBootstrapResamples, "bootstrap resamples for delta confidence intervals (0 = disabled)")
+	compareCmd.Flags().Int64("seed", comparator.DefaultBootstrapSeed, "random seed for bootstrap resampling")
 	compareCmd.Flags().StringP("format", "f", "markdown", "output format: markdown, html, or json (default: markdown)")
 	compareCmd.Flags().StringP("output", "o", "", "output file path (default: stdout)")
 
@@ -49,6 +62,14 @@ func compareBenchmarks(cmd *cobra.Command, args []string) error {
 	confidence, _ := cmd.Flags().GetFloat64("confidence")
 	format, _ := cmd.Flags().GetString("format")
 	outputPath, _ := cmd.Flags().GetString("output")
+	testName, _ := cmd.Flags().GetString("test")
+	correctionName, _ := cmd.Flags().GetString("correction")
+	policyName, _ := cmd.Flags().GetString("policy")
+	renamesPath, _ := cmd.Flags().GetString("renames")
+	minEffectSize, _ := cmd.Flags().GetFloat64("min-effect-size")
+	resamples, _ := cmd.Flags().GetInt("bootstrap-resamples")
+	seed, _ := cmd.Flags().GetInt64("seed")
+	metricThresholdSpecs, _ := cmd.Flags().GetStringSlice("metric-threshold")
 
 	// Validate format
 	if format != "markdown" && format != "html" && format != "json" {
@@ -65,6 +86,43 @@ func compareBenchmarks(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("threshold must be greater than 1.0 (e.g., 1.05 for 5%% regression)")
 	}
 
+	// Validate bootstrap resamples
+	if resamples < 0 {
+		return fmt.Errorf("bootstrap resamples must not be negative")
+	}
+
+	// Validate statistical test
+	test, err := comparator.ParseStatisticalTest(testName)
+	if err != nil {
+		return err
+	}
+
+	// Validate multiple-comparison correction
+	correction, err := comparator.ParseCorrection(correctionName)
+	if err != nil {
+		return err
+	}
+
+	// Validate regression policy
+	policy, err := comparator.ParseRegressionPolicy(policyName)
+	if err != nil {
+		return err
+	}
+
+	if minEffectSize < 0 {
+		return fmt.Errorf("minimum effect size must not be negative")
+	}
+
+	// Validate per-metric thresholds
+	metricThresholds := make(map[string]float64)
+	for _, spec := range metricThresholdSpecs {
+		name, metricThreshold, err := comparator.ParseMetricThreshold(spec)
+		if err != nil {
+			return err
+		}
+		metricThresholds[name] = metricThreshold
+	}
+
 	slog.Info("Loading benchmark suites",
 		"baseline", baselinePath,
 		"current", currentPath)
@@ -85,14 +143,36 @@ func compareBenchmarks(cmd *cobra.Command, args []string) error {
 
 	slog.Info("Loaded current suite", "benchmarks", len(currentSuite.Results))
 
+	// Load rename mapping
+	var renames map[string]string
+	if renamesPath != "" {
+		renames, err = loadRenames(renamesPath)
+		if err != nil {
+			return fmt.Errorf("failed to load renames: %w", err)
+		}
+		slog.Info("Loaded rename mapping", "renames", len(renames))
+	}
+
 	// Create comparator
 	comp := comparator.NewBasicComparator()
 	comp.RegressionThreshold = threshold
 	comp.ConfidenceLevel = confidence
+	comp.Test = test
+	comp.Correction = correction
+	comp.Policy = policy
+	comp.MinEffectSize = minEffectSize
+	comp.Renames = renames
+	comp.MetricThresholds = metricThresholds
+	comp.BootstrapResamples = resamples
+	comp.BootstrapSeed = seed
 
 	slog.Info("Performing comparison",
 		"threshold", threshold,
-		"confidence", confidence)
+		"confidence", confidence,
+		"test", test,
+		"correction", correction,
+		"policy", policy,
+		"bootstrap_resamples", resamples)
 
 	// Compare suites
 	result := comp.Compare(baselineSuite, currentSuite)
@@ -101,7 +181,9 @@ func compareBenchmarks(cmd *cobra.Command, args []string) error {
 		"total", result.Summary.TotalComparisons,
 		"regressions", result.Summary.Regressions,
 		"improvements", result.Summary.Improvements,
-		"significant", result.Summary.SignificantChanges)
+		"significant", result.Summary.SignificantChanges,
+		"added", len(result.Added),
+		"removed", len(result.Removed))
 
 	// Generate report
 	var report string
@@ -142,16 +224,32 @@ func compareBenchmarks(cmd *cobra.Command, args []string) error {
 	fmt.Fprintf(os.Stderr, "Regressions:      %d\n", result.Summary.Regressions)
 	fmt.Fprintf(os.Stderr, "Improvements:     %d\n", result.Summary.Improvements)
 	fmt.Fprintf(os.Stderr, "Significant:      %d\n", result.Summary.SignificantChanges)
+	if result.Summary.ThresholdFallbacks > 0 {
+		fmt.Fprintf(os.Stderr, "Threshold Only:   %d (no variance data for significance test)\n", result.Summary.ThresholdFallbacks)
+	}
 	fmt.Fprintf(os.Stderr, "Average Delta:    %.2f%%\n", result.Summary.AverageDelta)
 	fmt.Fprintf(os.Stderr, "Max Delta:        %.2f%%\n", result.Summary.MaxDelta)
 	fmt.Fprintf(os.Stderr, "Min Delta:        %.2f%%\n", result.Summary.MinDelta)
+	if len(result.Added) > 0 || len(result.Removed) > 0 {
+		fmt.Fprintf(os.Stderr, "Added/Removed:    %d/%d\n", len(result.Added), len(result.Removed))
+	}
+	if len(result.LanguageMismatches) > 0 {
+		fmt.Fprintf(os.Stderr, "Lang Mismatches:  %d\n", len(result.LanguageMismatches))
+	}
 	fmt.Fprintf(os.Stderr, "═══════════════════════════════════════════\n")
 
+	// Warn when the runs were taken on different platforms or hardware
+	for _, mismatch := range result.EnvironmentMismatches {
+		fmt.Fprintf(os.Stderr, "⚠️  Environment mismatch, results may not be comparable: %s\n", mismatch)
+	}
+
 	// Exit with error if regressions detected
 	if result.Summary.Regressions > 0 {
 		fmt.Fprintf(os.Stderr, "\n⚠️  Performance regressions detected!\n")
-		for _, name := range result.Regressions {
-			fmt.Fprintf(os.Stderr, "  • %s\n", name)
+		for _, comparison := range result.Benchmarks {
+			if comparison.IsRegression {
+				fmt.Fprintf(os.Stderr, "  • %s: %s\n", comparison.Name, comparison.RegressionReason)
+			}
 		}
 		return fmt.Errorf("performance regressions detected (%d)", result.Summary.Regressions)
 	}
@@ -252,6 +252,10 @@ func TestCompare_LanguageMismatch(t *testing.T) {
 	if result.Summary.TotalComparisons != 0 {
 		t.Errorf("Expected 0 comparisons for language mismatch, got %d", result.Summary.TotalComparisons)
 	}
+
+	if len(result.LanguageMismatches) != 1 || result.LanguageMismatches[0].BaselineLanguage != "rust" {
+		t.Errorf("Expected language mismatch to be reported, got %+v", result.LanguageMismatches)
+	}
 }
 
 func TestLoadBenchmarkSuite_Integration_JSONtoCSV(t *testing.T) {
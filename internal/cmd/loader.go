@@ -10,11 +10,18 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jpequegn/benchflow/internal/aggregator"
+	"github.com/jpequegn/benchflow/internal/comparator"
 	"github.com/jpequegn/benchflow/internal/parser"
 )
 
 // LoadBenchmarkSuite loads a benchmark suite from a file (JSON or CSV)
+// or from a Criterion.rs results directory (e.g., target/criterion)
 func LoadBenchmarkSuite(filePath string) (*parser.BenchmarkSuite, error) {
+	if info, err := os.Stat(filePath); err == nil && info.IsDir() {
+		return parser.ParseCriterionDirectory(filePath)
+	}
+
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open file: %w", err)
@@ -28,25 +35,55 @@ func LoadBenchmarkSuite(filePath string) (*parser.BenchmarkSuite, error) {
 		return loadBenchmarkFromCSV(file)
 	}
 
-	return nil, fmt.Errorf("unsupported file format: %s (must be .json or .csv)", filePath)
+	return nil, fmt.Errorf("unsupported file format: %s (must be .json, .csv or a Criterion directory)", filePath)
+}
+
+// loadRenames loads a benchmark rename mapping file ("old_name -> new_name" per line)
+func loadRenames(filePath string) (map[string]string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	return comparator.ParseRenames(file)
 }
 
 // loadBenchmarkFromJSON loads benchmark suite from JSON format
-// Expected format matches the reporter JSON output structure
+// Expected format matches the reporter JSON output structure ("benchmarks"), or the
+// aggregated suite `run` and `report` export ("results")
 func loadBenchmarkFromJSON(r io.Reader) (*parser.BenchmarkSuite, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JSON: %w", err)
+	}
+
 	var data map[string]interface{}
-	if err := json.NewDecoder(r).Decode(&data); err != nil {
+	if err := json.Unmarshal(raw, &data); err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
 
 	// Extract benchmarks array
 	benchmarksData, ok := data["benchmarks"].([]interface{})
 	if !ok {
+		if _, exported := data["results"].([]interface{}); exported {
+			return loadAggregatedSuiteFromJSON(raw)
+		}
 		return nil, fmt.Errorf("invalid JSON format: missing or invalid 'benchmarks' field")
 	}
 
 	suite := &parser.BenchmarkSuite{
-		Results: make([]*parser.BenchmarkResult, 0, len(benchmarksData)),
+		Results:  make([]*parser.BenchmarkResult, 0, len(benchmarksData)),
+		Metadata: make(map[string]string),
+	}
+
+	// Parse suite metadata if present (e.g., goos, goarch, cpu)
+	if metadata, ok := data["metadata"].(map[string]interface{}); ok {
+		for key, value := range metadata {
+			if str, ok := value.(string); ok {
+				suite.Metadata[key] = str
+			}
+		}
 	}
 
 	for _, bData := range benchmarksData {
@@ -75,6 +112,95 @@ func loadBenchmarkFromJSON(r io.Reader) (*parser.BenchmarkSuite, error) {
 	return suite, nil
 }
 
+// loadAggregatedSuiteFromJSON loads an aggregated suite exported by `run` or `report`
+func loadAggregatedSuiteFromJSON(raw []byte) (*parser.BenchmarkSuite, error) {
+	var aggregated aggregator.AggregatedSuite
+	if err := json.Unmarshal(raw, &aggregated); err != nil {
+		return nil, fmt.Errorf("failed to parse aggregated JSON: %w", err)
+	}
+
+	suite := &parser.BenchmarkSuite{
+		Results:   make([]*parser.BenchmarkResult, 0, len(aggregated.Results)),
+		Timestamp: aggregated.Timestamp,
+		Metadata:  make(map[string]string),
+	}
+	for key, value := range aggregated.Metadata {
+		suite.Metadata[key] = value
+	}
+
+	for _, r := range aggregated.Results {
+		result := aggregatedResultToBenchmark(r)
+		suite.Results = append(suite.Results, result)
+		if suite.Language == "" {
+			suite.Language = result.Language
+		}
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, fmt.Errorf("no valid benchmarks found in JSON")
+	}
+
+	return suite, nil
+}
+
+// aggregatedResultToBenchmark converts an aggregated result back into a benchmark result.
+// Metric means become metric values; memory with only one side measured (e.g., .NET
+// bytes without allocation counts) is kept as a named metric rather than Memory.
+func aggregatedResultToBenchmark(r *aggregator.AggregatedResult) *parser.BenchmarkResult {
+	result := &parser.BenchmarkResult{
+		Name:        r.Name,
+		Language:    r.Language,
+		Time:        r.Mean,
+		StdDev:      r.StdDev,
+		Iterations:  r.Iterations,
+		Samples:     r.Samples,
+		SampleCount: int64(len(r.Samples)),
+		Metadata: map[string]string{
+			parser.MetadataMedianNs: strconv.FormatInt(r.Median.Nanoseconds(), 10),
+			parser.MetadataMinNs:    strconv.FormatInt(r.Min.Nanoseconds(), 10),
+			parser.MetadataMaxNs:    strconv.FormatInt(r.Max.Nanoseconds(), 10),
+		},
+	}
+	if r.Package != "" {
+		result.Metadata[parser.MetadataPackage] = r.Package
+	}
+	if r.Group != "" {
+		result.Metadata[parser.MetadataGroup] = r.Group
+	}
+	for name, value := range r.Params {
+		result.Metadata[parser.MetadataParamPrefix+name] = value
+	}
+
+	switch {
+	case r.BytesPerOp != nil && r.AllocsPerOp != nil:
+		result.Memory = &parser.MemoryStats{BytesPerOp: *r.BytesPerOp, AllocsPerOp: *r.AllocsPerOp}
+	case r.BytesPerOp != nil:
+		result.Metrics = append(result.Metrics, parser.Metric{
+			Name: parser.MetricBytesPerOp, Unit: "B/op", Value: float64(*r.BytesPerOp), Deterministic: true,
+		})
+	case r.AllocsPerOp != nil:
+		result.Metrics = append(result.Metrics, parser.Metric{
+			Name: parser.MetricAllocsPerOp, Unit: "allocs/op", Value: float64(*r.AllocsPerOp), Deterministic: true,
+		})
+	}
+
+	for _, m := range r.Metrics {
+		if m.Name == parser.MetricThroughput {
+			result.Throughput = &parser.Throughput{Value: m.Mean, Unit: m.Unit}
+			continue
+		}
+		result.Metrics = append(result.Metrics, parser.Metric{
+			Name:           m.Name,
+			Unit:           m.Unit,
+			Value:          m.Mean,
+			HigherIsBetter: m.HigherIsBetter,
+			Deterministic:  m.Deterministic,
+		})
+	}
+
+	return result
+}
+
 // parseBenchmarkFromJSON parses a single benchmark from JSON map
 func parseBenchmarkFromJSON(data map[string]interface{}) (*parser.BenchmarkResult, error) {
 	result := &parser.BenchmarkResult{}
@@ -106,11 +232,73 @@ func parseBenchmarkFromJSON(data map[string]interface{}) (*parser.BenchmarkResul
 		result.StdDev = time.Duration(int64(stdDev))
 	}
 
+	// Parse the number of samples behind the mean and standard deviation if present
+	if count, ok := data["sample_count"].(float64); ok {
+		result.SampleCount = int64(count)
+	}
+
+	// Parse throughput if present (higher is better, e.g. ops/s or MB/s)
+	if value, ok := data["throughput"].(float64); ok {
+		unit, _ := data["throughput_unit"].(string)
+		if unit == "" {
+			unit = "ops/s"
+		}
+		result.Throughput = &parser.Throughput{Value: value, Unit: unit}
+	}
+
+	// Parse memory metrics if present
+	bytesPerOp, hasBytes := data["bytes_per_op"].(float64)
+	allocsPerOp, hasAllocs := data["allocs_per_op"].(float64)
+	if hasBytes || hasAllocs {
+		result.Memory = &parser.MemoryStats{
+			BytesPerOp:  int64(bytesPerOp),
+			AllocsPerOp: int64(allocsPerOp),
+		}
+	}
+
+	// Parse additional named metrics if present
+	if metrics, ok := data["metrics"].([]interface{}); ok {
+		for _, item := range metrics {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid metrics entry: %v", item)
+			}
+			name, _ := m["name"].(string)
+			value, ok := m["value"].(float64)
+			if name == "" || !ok {
+				return nil, fmt.Errorf("metrics entries need a name and a numeric value: %v", item)
+			}
+			unit, _ := m["unit"].(string)
+			higherIsBetter, _ := m["higher_is_better"].(bool)
+			deterministic, _ := m["deterministic"].(bool)
+			result.Metrics = append(result.Metrics, parser.Metric{
+				Name:           name,
+				Unit:           unit,
+				Value:          value,
+				HigherIsBetter: higherIsBetter,
+				Deterministic:  deterministic,
+			})
+		}
+	}
+
+	// Parse raw samples if present
+	if samples, ok := data["samples_ns"].([]interface{}); ok {
+		result.Samples = make([]time.Duration, 0, len(samples))
+		for _, s := range samples {
+			ns, ok := s.(float64)
+			if !ok {
+				return nil, fmt.Errorf("invalid samples_ns value: %v", s)
+			}
+			result.Samples = append(result.Samples, time.Duration(int64(ns)))
+		}
+	}
+
 	return result, nil
 }
 
 // loadBenchmarkFromCSV loads benchmark suite from CSV format
-// Expected columns: name, language, time_ns, std_dev_ns, iterations
+// Expected columns: name, language, time_ns, std_dev_ns, iterations, sample_count, bytes_per_op,
+// allocs_per_op, throughput, throughput_unit
 func loadBenchmarkFromCSV(r io.Reader) (*parser.BenchmarkSuite, error) {
 	reader := csv.NewReader(r)
 
@@ -189,6 +377,55 @@ func loadBenchmarkFromCSV(r io.Reader) (*parser.BenchmarkSuite, error) {
 			}
 		}
 
+		// Parse sample_count if present
+		if idx, ok := columnIndex["sample_count"]; ok && idx < len(record) {
+			if val := strings.TrimSpace(record[idx]); val != "" {
+				count, err := strconv.ParseInt(val, 10, 64)
+				if err == nil {
+					result.SampleCount = count
+				}
+			}
+		}
+
+		// Parse memory metrics if present
+		for _, col := range []string{"bytes_per_op", "allocs_per_op"} {
+			idx, ok := columnIndex[col]
+			if !ok || idx >= len(record) {
+				continue
+			}
+			val := strings.TrimSpace(record[idx])
+			if val == "" {
+				continue
+			}
+			n, err := strconv.ParseInt(val, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s value: %w", col, err)
+			}
+			if result.Memory == nil {
+				result.Memory = &parser.MemoryStats{}
+			}
+			if col == "bytes_per_op" {
+				result.Memory.BytesPerOp = n
+			} else {
+				result.Memory.AllocsPerOp = n
+			}
+		}
+
+		// Parse throughput if present
+		if idx, ok := columnIndex["throughput"]; ok && idx < len(record) {
+			if val := strings.TrimSpace(record[idx]); val != "" {
+				value, err := strconv.ParseFloat(val, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid throughput value: %w", err)
+				}
+				unit := "ops/s"
+				if idx, ok := columnIndex["throughput_unit"]; ok && idx < len(record) && strings.TrimSpace(record[idx]) != "" {
+					unit = strings.TrimSpace(record[idx])
+				}
+				result.Throughput = &parser.Throughput{Value: value, Unit: unit}
+			}
+		}
+
 		suite.Results = append(suite.Results, result)
 		if suite.Language == "" {
 			suite.Language = result.Language
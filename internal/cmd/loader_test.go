@@ -5,6 +5,10 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/jpequegn/benchflow/internal/comparator"
+	"github.com/jpequegn/benchflow/internal/executor"
+	"github.com/jpequegn/benchflow/internal/parser"
 )
 
 func TestLoadBenchmarkSuite_JSON(t *testing.T) {
@@ -24,7 +28,8 @@ func TestLoadBenchmarkSuite_JSON(t *testing.T) {
       "language": "go",
       "baseline_time_ns": 1000,
       "std_dev_ns": 50,
-      "iterations": 100
+      "iterations": 100,
+      "sample_count": 10
     },
     {
       "name": "search",
@@ -72,6 +77,278 @@ func TestLoadBenchmarkSuite_JSON(t *testing.T) {
 	if suite.Results[0].Iterations != 100 {
 		t.Errorf("Expected iterations 100, got %d", suite.Results[0].Iterations)
 	}
+
+	if suite.Results[0].SampleCount != 10 {
+		t.Errorf("Expected sample count 10, got %d", suite.Results[0].SampleCount)
+	}
+}
+
+func TestLoadBenchmarkSuite_JSONSamples(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonFile := filepath.Join(tmpDir, "benchmarks.json")
+
+	jsonContent := `{
+  "benchmarks": [
+    {"name": "sort", "language": "go", "baseline_time_ns": 1000, "samples_ns": [900, 1000, 1100]},
+    {"name": "search", "language": "go", "baseline_time_ns": 500}
+  ]
+}`
+
+	if err := os.WriteFile(jsonFile, []byte(jsonContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	suite, err := LoadBenchmarkSuite(jsonFile)
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+
+	samples := suite.Results[0].Samples
+	if len(samples) != 3 || samples[0] != 900*time.Nanosecond || samples[2] != 1100*time.Nanosecond {
+		t.Errorf("Expected samples [900ns 1µs 1.1µs], got %v", samples)
+	}
+
+	if suite.Results[1].Samples != nil {
+		t.Errorf("Expected no samples, got %v", suite.Results[1].Samples)
+	}
+
+	invalidFile := filepath.Join(tmpDir, "invalid.json")
+	invalidContent := `{"benchmarks": [{"name": "sort", "language": "go", "baseline_time_ns": 1000, "samples_ns": ["fast"]}]}`
+	if err := os.WriteFile(invalidFile, []byte(invalidContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	if _, err := LoadBenchmarkSuite(invalidFile); err == nil {
+		t.Error("Expected error for non-numeric samples")
+	}
+}
+
+func TestLoadBenchmarkSuite_MemoryMetrics(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	jsonFile := filepath.Join(tmpDir, "benchmarks.json")
+	jsonContent := `{
+  "benchmarks": [
+    {"name": "encode", "language": "go", "baseline_time_ns": 1000, "bytes_per_op": 512, "allocs_per_op": 0},
+    {"name": "decode", "language": "go", "baseline_time_ns": 500}
+  ]
+}`
+	if err := os.WriteFile(jsonFile, []byte(jsonContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	suite, err := LoadBenchmarkSuite(jsonFile)
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+	if m := suite.Results[0].Memory; m == nil || m.BytesPerOp != 512 || m.AllocsPerOp != 0 {
+		t.Errorf("Expected 512 B/op and 0 allocs/op, got %+v", m)
+	}
+	if suite.Results[1].Memory != nil {
+		t.Errorf("Expected no memory metrics, got %+v", suite.Results[1].Memory)
+	}
+
+	csvFile := filepath.Join(tmpDir, "benchmarks.csv")
+	csvContent := `name,language,time_ns,bytes_per_op,allocs_per_op
+encode,go,1000,512,4
+decode,go,500,,`
+	if err := os.WriteFile(csvFile, []byte(csvContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	suite, err = LoadBenchmarkSuite(csvFile)
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+	if m := suite.Results[0].Memory; m == nil || m.BytesPerOp != 512 || m.AllocsPerOp != 4 {
+		t.Errorf("Expected 512 B/op and 4 allocs/op, got %+v", m)
+	}
+	if suite.Results[1].Memory != nil {
+		t.Errorf("Expected no memory metrics, got %+v", suite.Results[1].Memory)
+	}
+}
+
+func TestLoadBenchmarkSuite_Throughput(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	jsonFile := filepath.Join(tmpDir, "benchmarks.json")
+	jsonContent := `{
+  "benchmarks": [
+    {"name": "forEach", "language": "nodejs", "baseline_time_ns": 810, "throughput": 1234567},
+    {"name": "copy", "language": "rust", "baseline_time_ns": 1234, "throughput": 830, "throughput_unit": "MB/s"}
+  ]
+}`
+	if err := os.WriteFile(jsonFile, []byte(jsonContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	suite, err := LoadBenchmarkSuite(jsonFile)
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+	if tp := suite.Results[0].Throughput; tp == nil || tp.Value != 1234567 || tp.Unit != "ops/s" {
+		t.Errorf("Expected 1234567 ops/s, got %+v", tp)
+	}
+	if tp := suite.Results[1].Throughput; tp == nil || tp.Unit != "MB/s" {
+		t.Errorf("Expected MB/s throughput, got %+v", tp)
+	}
+
+	csvFile := filepath.Join(tmpDir, "benchmarks.csv")
+	csvContent := `name,language,time_ns,throughput,throughput_unit
+forEach,nodejs,810,1234567,
+copy,rust,1234,830,MB/s
+plain,rust,500,,`
+	if err := os.WriteFile(csvFile, []byte(csvContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	suite, err = LoadBenchmarkSuite(csvFile)
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+	if tp := suite.Results[0].Throughput; tp == nil || tp.Unit != "ops/s" {
+		t.Errorf("Expected default ops/s unit, got %+v", tp)
+	}
+	if tp := suite.Results[1].Throughput; tp == nil || tp.Value != 830 || tp.Unit != "MB/s" {
+		t.Errorf("Expected 830 MB/s, got %+v", tp)
+	}
+	if suite.Results[2].Throughput != nil {
+		t.Errorf("Expected no throughput, got %+v", suite.Results[2].Throughput)
+	}
+}
+
+func TestLoadBenchmarkSuite_NamedMetrics(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonFile := filepath.Join(tmpDir, "benchmarks.json")
+
+	jsonContent := `{
+  "benchmarks": [
+    {"name": "lookup", "language": "rust", "baseline_time_ns": 1000, "metrics": [
+      {"name": "instructions", "unit": "instr", "value": 1520, "deterministic": true},
+      {"name": "hit_rate", "unit": "%", "value": 92.5, "higher_is_better": true}
+    ]}
+  ]
+}`
+	if err := os.WriteFile(jsonFile, []byte(jsonContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	suite, err := LoadBenchmarkSuite(jsonFile)
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+
+	metrics := suite.Results[0].Metrics
+	if len(metrics) != 2 {
+		t.Fatalf("Expected 2 metrics, got %d", len(metrics))
+	}
+	if metrics[0].Name != "instructions" || metrics[0].Value != 1520 || metrics[0].HigherIsBetter || !metrics[0].Deterministic {
+		t.Errorf("Unexpected first metric: %+v", metrics[0])
+	}
+	if metrics[1].Unit != "%" || !metrics[1].HigherIsBetter || metrics[1].Deterministic {
+		t.Errorf("Unexpected second metric: %+v", metrics[1])
+	}
+
+	invalidFile := filepath.Join(tmpDir, "invalid.json")
+	invalidContent := `{"benchmarks": [{"name": "lookup", "language": "rust", "baseline_time_ns": 1000, "metrics": [{"name": "instructions"}]}]}`
+	if err := os.WriteFile(invalidFile, []byte(invalidContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+	if _, err := LoadBenchmarkSuite(invalidFile); err == nil {
+		t.Error("Expected error for a metric without a value")
+	}
+}
+
+func TestLoadBenchmarkSuite_Metadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonFile := filepath.Join(tmpDir, "benchmarks.json")
+
+	jsonContent := `{
+  "metadata": {"goos": "linux", "goarch": "amd64", "cpu": "AMD EPYC 7763 64-Core Processor"},
+  "benchmarks": [
+    {"name": "BenchmarkSort-8", "language": "go", "baseline_time_ns": 1000}
+  ]
+}`
+	if err := os.WriteFile(jsonFile, []byte(jsonContent), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	suite, err := LoadBenchmarkSuite(jsonFile)
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+
+	if suite.Metadata["goarch"] != "amd64" || suite.Metadata["cpu"] != "AMD EPYC 7763 64-Core Processor" {
+		t.Errorf("Unexpected metadata: %v", suite.Metadata)
+	}
+}
+
+func TestLoadBenchmarkSuite_RunExport(t *testing.T) {
+	suite := &parser.BenchmarkSuite{
+		Language: "go",
+		Results: []*parser.BenchmarkResult{
+			{
+				Name:       "BenchmarkEncode-8",
+				Language:   "go",
+				Time:       1000 * time.Nanosecond,
+				Iterations: 100,
+				Samples:    []time.Duration{990 * time.Nanosecond, 1000 * time.Nanosecond, 1010 * time.Nanosecond},
+				Memory:     &parser.MemoryStats{BytesPerOp: 512, AllocsPerOp: 4},
+				Throughput: &parser.Throughput{Value: 85.5, Unit: "MB/s"},
+				Metadata:   map[string]string{parser.MetadataPackage: "example.com/codec", parser.MetadataGroup: "encode", "param.size": "1k"},
+			},
+			{
+				Name:     "bench_fib",
+				Language: "rust",
+				Metrics:  []parser.Metric{{Name: "l1_hits", Unit: "hits", Value: 9000, Deterministic: true}},
+			},
+		},
+	}
+
+	// Write the JSON report `run` produces, then load it back like `compare` does
+	dir := t.TempDir()
+	results := []*executor.ExecutionResult{{Config: &executor.BenchmarkConfig{Name: "mixed"}, Suite: suite}}
+	if _, err := writeRunReports(results, dir, []string{"json"}, true, time.Now()); err != nil {
+		t.Fatalf("writeRunReports() error = %v", err)
+	}
+
+	loaded, err := LoadBenchmarkSuite(filepath.Join(dir, "latest.json"))
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite() error = %v", err)
+	}
+	if len(loaded.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(loaded.Results))
+	}
+
+	byName := make(map[string]*parser.BenchmarkResult)
+	for _, r := range loaded.Results {
+		byName[r.Name] = r
+	}
+
+	encode := byName["BenchmarkEncode-8"]
+	if encode == nil || encode.Time != 1000*time.Nanosecond || len(encode.Samples) != 3 || encode.SampleCount != 3 {
+		t.Fatalf("BenchmarkEncode-8 = %+v, want 1µs with 3 samples", encode)
+	}
+	if encode.Memory == nil || encode.Memory.BytesPerOp != 512 || encode.Memory.AllocsPerOp != 4 {
+		t.Errorf("Memory = %+v, want 512 B/op and 4 allocs/op", encode.Memory)
+	}
+	if encode.Throughput == nil || encode.Throughput.Value != 85.5 || encode.Throughput.Unit != "MB/s" {
+		t.Errorf("Throughput = %+v, want 85.5 MB/s", encode.Throughput)
+	}
+	if encode.Metadata[parser.MetadataPackage] != "example.com/codec" || encode.Metadata[parser.MetadataGroup] != "encode" || encode.Metadata["param.size"] != "1k" {
+		t.Errorf("Metadata = %v, want package, group and param labels", encode.Metadata)
+	}
+
+	fib := byName["bench_fib"]
+	if fib == nil || len(fib.Metrics) != 1 || fib.Metrics[0].Name != "l1_hits" || fib.Metrics[0].Value != 9000 || !fib.Metrics[0].Deterministic {
+		t.Errorf("bench_fib metrics = %+v, want deterministic l1_hits", fib)
+	}
+
+	// The loaded export compares against itself benchmark by benchmark
+	result := comparator.NewBasicComparator().Compare(loaded, loaded)
+	if len(result.Benchmarks) != 2 || result.Summary.Regressions != 0 {
+		t.Errorf("Compare() = %d benchmarks, %d regressions, want 2 and 0", len(result.Benchmarks), result.Summary.Regressions)
+	}
 }
 
 func TestLoadBenchmarkSuite_CSV(t *testing.T) {
@@ -109,6 +386,31 @@ search,go,500,25,100`
 	}
 }
 
+func TestLoadBenchmarkSuite_CriterionDirectory(t *testing.T) {
+	newDir := filepath.Join(t.TempDir(), "criterion", "sort", "new")
+	if err := os.MkdirAll(newDir, 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	files := map[string]string{
+		"benchmark.json": `{"group_id":"sort","function_id":null,"value_str":null,"throughput":null,"full_id":"sort"}`,
+		"estimates.json": `{"mean":{"confidence_interval":{"confidence_level":0.95,"lower_bound":990.0,"upper_bound":1010.0},"point_estimate":1000.0,"standard_error":5.0},"std_dev":{"confidence_interval":{"confidence_level":0.95,"lower_bound":15.0,"upper_bound":25.0},"point_estimate":20.0,"standard_error":2.0}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(newDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+	}
+
+	suite, err := LoadBenchmarkSuite(filepath.Dir(filepath.Dir(newDir)))
+	if err != nil {
+		t.Fatalf("LoadBenchmarkSuite failed: %v", err)
+	}
+
+	if len(suite.Results) != 1 || suite.Results[0].Name != "sort" || suite.Results[0].Time != 1000*time.Nanosecond {
+		t.Errorf("Unexpected results: %+v", suite.Results)
+	}
+}
+
 func TestLoadBenchmarkSuite_UnsupportedFormat(t *testing.T) {
 	tmpDir := t.TempDir()
 	txtFile := filepath.Join(tmpDir, "benchmarks.txt")
@@ -262,3 +564,23 @@ search,rust,500`
 		t.Errorf("Expected zero iterations, got %d", suite.Results[0].Iterations)
 	}
 }
+
+func TestLoadRenames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "renames.txt")
+	content := "# refactor of sort package\nBenchmarkSort -> BenchmarkSortInts\n\nBenchmarkFind -> BenchmarkSearch\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write renames: %v", err)
+	}
+
+	renames, err := loadRenames(path)
+	if err != nil {
+		t.Fatalf("loadRenames() error = %v", err)
+	}
+	if len(renames) != 2 || renames["BenchmarkSort"] != "BenchmarkSortInts" {
+		t.Errorf("unexpected renames: %v", renames)
+	}
+
+	if _, err := loadRenames(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/aggregator"
+	"github.com/jpequegn/benchflow/internal/executor"
+	"github.com/jpequegn/benchflow/internal/parser"
+	"github.com/jpequegn/benchflow/internal/reporter"
+)
+
+// reportTimestampLayout is used for timestamped report file names
+const reportTimestampLayout = "20060102-150405"
+
+// writeRunReports writes one report per format into dir for all successful results.
+// Each report is written with a timestamped name and copied to latest.<format>;
+// darkMode selects the HTML theme. Formats are checked before anything is written.
+// Returns the paths of the timestamped reports.
+func writeRunReports(results []*executor.ExecutionResult, dir string, formats []string, darkMode bool, now time.Time) ([]string, error) {
+	reportFormats := make([]reporter.ReportFormat, 0, len(formats))
+	for _, format := range formats {
+		reportFormat := reporter.ReportFormat(strings.ToLower(strings.TrimSpace(format)))
+		switch reportFormat {
+		case reporter.FormatHTML, reporter.FormatJSON, reporter.FormatCSV:
+			reportFormats = append(reportFormats, reportFormat)
+		default:
+			return nil, fmt.Errorf("unsupported format: %s", format)
+		}
+	}
+
+	suite := mergeRunSuites(results, now)
+	if len(suite.Results) == 0 {
+		return nil, nil
+	}
+
+	aggregated, err := aggregator.NewAggregator().Aggregate(suite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate results: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	written := make([]string, 0, len(reportFormats))
+	for _, format := range reportFormats {
+		opts := &reporter.ReportOptions{
+			Title:       "Benchmark Report",
+			Format:      format,
+			Type:        reporter.TypeSummary,
+			DarkMode:    darkMode,
+			ShowCharts:  true,
+			ShowDetails: true,
+		}
+
+		var buf bytes.Buffer
+		if err := renderSuiteReport(aggregated, opts, &buf); err != nil {
+			return written, fmt.Errorf("failed to generate %s report: %w", format, err)
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("benchflow-%s.%s", now.Format(reportTimestampLayout), format))
+		if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			return written, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+
+		latestPath := filepath.Join(dir, "latest."+string(format))
+		if err := os.WriteFile(latestPath, buf.Bytes(), 0644); err != nil {
+			return written, fmt.Errorf("failed to write %s: %w", latestPath, err)
+		}
+
+		written = append(written, path)
+	}
+
+	return written, nil
+}
+
+// mergeRunSuites combines the suites of all successful results into a single suite
+func mergeRunSuites(results []*executor.ExecutionResult, now time.Time) *parser.BenchmarkSuite {
+	merged := &parser.BenchmarkSuite{
+		Results:   make([]*parser.BenchmarkResult, 0),
+		Timestamp: now,
+		Metadata:  make(map[string]string),
+	}
+
+	names := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Error != nil || result.Suite == nil {
+			continue
+		}
+
+		merged.Results = append(merged.Results, result.Suite.Results...)
+		names = append(names, result.Config.Name)
+
+		if merged.Language == "" {
+			merged.Language = result.Suite.Language
+		} else if merged.Language != result.Suite.Language {
+			merged.Language = "mixed"
+		}
+
+		// Keep the environment the suites report (goos, goarch, cpu); packages accumulate
+		for key, value := range result.Suite.Metadata {
+			existing, ok := merged.Metadata[key]
+			switch {
+			case !ok:
+				merged.Metadata[key] = value
+			case key == parser.MetadataPackage && existing != value:
+				merged.Metadata[key] = existing + ", " + value
+			}
+		}
+	}
+
+	merged.Metadata["benchmarks"] = strings.Join(names, ",")
+
+	return merged
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/executor"
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestWriteRunReports(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "reports")
+	now := time.Date(2025, 10, 18, 14, 30, 0, 0, time.UTC)
+
+	results := []*executor.ExecutionResult{
+		{
+			Config: &executor.BenchmarkConfig{Name: "go-benchmarks", Language: "go"},
+			Suite:  testParserSuite(),
+		},
+		{
+			Config: &executor.BenchmarkConfig{Name: "rust-benchmarks", Language: "rust"},
+			Suite: &parser.BenchmarkSuite{
+				Language: "rust",
+				Results: []*parser.BenchmarkResult{
+					{Name: "bench_sort", Language: "rust", Time: 800 * time.Nanosecond},
+				},
+			},
+		},
+		{
+			Config: &executor.BenchmarkConfig{Name: "broken", Language: "python"},
+			Error:  errors.New("command failed"),
+		},
+	}
+
+	paths, err := writeRunReports(results, dir, []string{"html", "JSON", "csv"}, true, now)
+	if err != nil {
+		t.Fatalf("writeRunReports() error = %v", err)
+	}
+
+	if len(paths) != 3 {
+		t.Fatalf("expected 3 reports, got %d", len(paths))
+	}
+
+	for _, format := range []string{"html", "json", "csv"} {
+		stamped := filepath.Join(dir, "benchflow-20251018-143000."+format)
+		latest := filepath.Join(dir, "latest."+format)
+
+		stampedData, err := os.ReadFile(stamped)
+		if err != nil {
+			t.Fatalf("expected %s to exist: %v", stamped, err)
+		}
+		latestData, err := os.ReadFile(latest)
+		if err != nil {
+			t.Fatalf("expected %s to exist: %v", latest, err)
+		}
+		if string(stampedData) != string(latestData) {
+			t.Errorf("expected latest.%s to match timestamped report", format)
+		}
+	}
+
+	csvData, _ := os.ReadFile(filepath.Join(dir, "latest.csv"))
+	if !strings.Contains(string(csvData), "bench_sort") || !strings.Contains(string(csvData), "BenchmarkSort-8") {
+		t.Error("expected CSV report to include results from all successful benchmarks")
+	}
+}
+
+func TestWriteRunReports_InvalidFormat(t *testing.T) {
+	results := []*executor.ExecutionResult{
+		{
+			Config: &executor.BenchmarkConfig{Name: "go-benchmarks", Language: "go"},
+			Suite:  testParserSuite(),
+		},
+	}
+
+	dir := t.TempDir()
+	_, err := writeRunReports(results, dir, []string{"html", "pdf"}, true, time.Now())
+	if err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read output directory: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no reports before formats are validated, got %d files", len(entries))
+	}
+}
+
+func TestWriteRunReports_DarkMode(t *testing.T) {
+	results := []*executor.ExecutionResult{
+		{
+			Config: &executor.BenchmarkConfig{Name: "go-benchmarks", Language: "go"},
+			Suite:  testParserSuite(),
+		},
+	}
+
+	for _, darkMode := range []bool{true, false} {
+		dir := t.TempDir()
+		if _, err := writeRunReports(results, dir, []string{"html"}, darkMode, time.Now()); err != nil {
+			t.Fatalf("writeRunReports() error = %v", err)
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, "latest.html"))
+		if err != nil {
+			t.Fatalf("expected latest.html to exist: %v", err)
+		}
+		// The light theme overrides the background color
+		if got := strings.Contains(string(data), "#F5F6FA"); got == darkMode {
+			t.Errorf("darkMode = %v: light theme included = %v", darkMode, got)
+		}
+	}
+}
+
+func TestWriteRunReports_NoResults(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "reports")
+
+	paths, err := writeRunReports(nil, dir, []string{"html"}, true, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 0 {
+		t.Errorf("expected no reports, got %v", paths)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("expected output directory not to be created")
+	}
+}
+
+func TestMergeRunSuites(t *testing.T) {
+	results := []*executor.ExecutionResult{
+		{Config: &executor.BenchmarkConfig{Name: "a"}, Suite: &parser.BenchmarkSuite{Language: "go", Results: []*parser.BenchmarkResult{{Name: "x"}}}},
+		{Config: &executor.BenchmarkConfig{Name: "b"}, Suite: &parser.BenchmarkSuite{Language: "rust", Results: []*parser.BenchmarkResult{{Name: "y"}}}},
+	}
+
+	merged := mergeRunSuites(results, time.Now())
+
+	if len(merged.Results) != 2 {
+		t.Errorf("expected 2 results, got %d", len(merged.Results))
+	}
+	if merged.Language != "mixed" {
+		t.Errorf("expected mixed language, got %s", merged.Language)
+	}
+	if merged.Metadata["benchmarks"] != "a,b" {
+		t.Errorf("expected benchmarks metadata 'a,b', got %q", merged.Metadata["benchmarks"])
+	}
+}
+
+func TestMergeRunSuites_Environment(t *testing.T) {
+	suite := func(pkg string) *parser.BenchmarkSuite {
+		return &parser.BenchmarkSuite{
+			Language: "go",
+			Results:  []*parser.BenchmarkResult{{Name: "BenchmarkX-8"}},
+			Metadata: map[string]string{"goos": "linux", "cpu": "Intel Xeon", "package": pkg},
+		}
+	}
+	results := []*executor.ExecutionResult{
+		{Config: &executor.BenchmarkConfig{Name: "a"}, Suite: suite("example.com/a")},
+		{Config: &executor.BenchmarkConfig{Name: "b"}, Suite: suite("example.com/b")},
+	}
+
+	merged := mergeRunSuites(results, time.Now())
+
+	if merged.Metadata["cpu"] != "Intel Xeon" || merged.Metadata["goos"] != "linux" {
+		t.Errorf("expected environment metadata to be kept, got %v", merged.Metadata)
+	}
+	if merged.Metadata["package"] != "example.com/a, example.com/b" {
+		t.Errorf("expected packages to accumulate, got %q", merged.Metadata["package"])
+	}
+}
@@ -1,8 +1,16 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
 
+	"github.com/jpequegn/benchflow/internal/aggregator"
+	"github.com/jpequegn/benchflow/internal/reporter"
 	"github.com/spf13/cobra"
 )
 
@@ -12,21 +20,14 @@ var reportCmd = &cobra.Command{
 	Short: "Generate benchmark reports",
 	Long: `Generate benchmark reports in various formats (HTML, JSON, CSV).
 
-Example:
-  benchflow report --format html --output report.html`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		format, _ := cmd.Flags().GetString("format")
-		output, _ := cmd.Flags().GetString("output")
-		input, _ := cmd.Flags().GetString("input")
-
-		if input == "" {
-			return fmt.Errorf("input flag is required (use --input to specify benchmark results)")
-		}
+The input can be a results file (JSON or CSV), a stored suite ID, or "latest"
+for the most recent suite saved by 'benchflow run'.
 
-		fmt.Printf("Generating %s report from %s: %s\n", format, input, output)
-		fmt.Println("Use 'benchflow run' to generate benchmark results")
-		return nil
-	},
+Example:
+  benchflow report --input results.json --format html --output report.html
+  benchflow report --input latest --format csv --output results.csv
+  benchflow report --input 42 --format html --dark-mode --output report.html`,
+	RunE: generateReport,
 }
 
 func init() {
@@ -34,9 +35,140 @@ func init() {
 
 	// Report-specific flags
 	reportCmd.Flags().StringP("format", "f", "html", "report format (html, json, csv)")
-	reportCmd.Flags().StringP("output", "o", "", "output file path (required)")
-	reportCmd.Flags().StringP("input", "i", "", "input benchmark results file")
+	reportCmd.Flags().StringP("output", "o", "", "output file path (default: stdout)")
+	reportCmd.Flags().StringP("input", "i", "", "benchmark results file, stored suite ID, or \"latest\"")
+	reportCmd.Flags().String("title", "Benchmark Report", "report title (HTML only)")
+	reportCmd.Flags().Bool("dark-mode", false, "use dark theme (HTML only)")
+	reportCmd.Flags().Bool("charts", true, "include charts (HTML only)")
+	reportCmd.Flags().Bool("details", true, "include detailed results table (HTML only)")
 
-	_ = reportCmd.MarkFlagRequired("output")
 	_ = reportCmd.MarkFlagRequired("input")
 }
+
+func generateReport(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	outputPath, _ := cmd.Flags().GetString("output")
+	input, _ := cmd.Flags().GetString("input")
+	title, _ := cmd.Flags().GetString("title")
+	darkMode, _ := cmd.Flags().GetBool("dark-mode")
+	showCharts, _ := cmd.Flags().GetBool("charts")
+	showDetails, _ := cmd.Flags().GetBool("details")
+
+	if input == "" {
+		return fmt.Errorf("input flag is required (use --input to specify benchmark results)")
+	}
+
+	reportFormat := reporter.ReportFormat(format)
+	if reportFormat != reporter.FormatHTML && reportFormat != reporter.FormatJSON && reportFormat != reporter.FormatCSV {
+		return fmt.Errorf("invalid format: %s (must be html, json, or csv)", format)
+	}
+
+	suite, err := loadReportSuite(input)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Loaded benchmark suite", "input", input, "benchmarks", len(suite.Results))
+
+	opts := &reporter.ReportOptions{
+		Title:       title,
+		Format:      reportFormat,
+		Type:        reporter.TypeSummary,
+		DarkMode:    darkMode,
+		ShowCharts:  showCharts,
+		ShowDetails: showDetails,
+	}
+
+	var buf bytes.Buffer
+	if err := renderSuiteReport(suite, opts, &buf); err != nil {
+		return fmt.Errorf("failed to generate %s report: %w", format, err)
+	}
+
+	if outputPath == "" {
+		_, err := os.Stdout.Write(buf.Bytes())
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	slog.Info("Report written", "path", outputPath)
+	fmt.Fprintf(os.Stderr, "Report saved to: %s\n", outputPath)
+
+	return nil
+}
+
+// loadReportSuite resolves the report input to an aggregated suite.
+// Inputs that name an existing file are loaded with LoadBenchmarkSuite and aggregated;
+// "latest" or a numeric suite ID are read from the configured storage.
+func loadReportSuite(input string) (*aggregator.AggregatedSuite, error) {
+	if _, statErr := os.Stat(input); statErr == nil {
+		suite, err := LoadBenchmarkSuite(input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load input: %w", err)
+		}
+
+		if suite.Timestamp.IsZero() {
+			suite.Timestamp = time.Now()
+		}
+
+		aggregated, err := aggregator.NewAggregator().Aggregate(suite)
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate input: %w", err)
+		}
+
+		return aggregated, nil
+	}
+
+	var suiteID int64
+	if input != "latest" {
+		id, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("input not found: %s (expected a JSON/CSV file, suite ID, or \"latest\")", input)
+		}
+		suiteID = id
+	}
+
+	store, err := openConfiguredStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = store.Close() }()
+
+	var suite *aggregator.AggregatedSuite
+	if input == "latest" {
+		suite, err = store.GetLatest()
+	} else {
+		suite, err = store.GetByID(suiteID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stored suite: %w", err)
+	}
+	if suite == nil {
+		return nil, fmt.Errorf("no stored suite found for %s", input)
+	}
+
+	return suite, nil
+}
+
+// renderSuiteReport writes a summary report for the suite in the format given by opts
+func renderSuiteReport(suite *aggregator.AggregatedSuite, opts *reporter.ReportOptions, w io.Writer) error {
+	switch opts.Format {
+	case reporter.FormatHTML:
+		htmlReporter, err := reporter.NewHTMLReporter()
+		if err != nil {
+			return err
+		}
+		return htmlReporter.GenerateSummary(suite, opts, w)
+	case reporter.FormatJSON, reporter.FormatCSV:
+		data, err := aggregator.NewAggregator().Export(suite, aggregator.ExportFormat(opts.Format))
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	default:
+		return fmt.Errorf("unsupported format: %s", opts.Format)
+	}
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/aggregator"
+	"github.com/jpequegn/benchflow/internal/parser"
+	"github.com/jpequegn/benchflow/internal/reporter"
+	"github.com/spf13/viper"
+)
+
+func TestLoadReportSuite_File(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "results.json")
+	content := `{
+  "benchmarks": [
+    {"name": "sort", "language": "go", "baseline_time_ns": 1000, "iterations": 10},
+    {"name": "search", "language": "go", "baseline_time_ns": 500, "iterations": 20}
+  ]
+}`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write input file: %v", err)
+	}
+
+	suite, err := loadReportSuite(inputFile)
+	if err != nil {
+		t.Fatalf("loadReportSuite() error = %v", err)
+	}
+
+	if len(suite.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(suite.Results))
+	}
+	if suite.Stats == nil || suite.Stats.FastestBench != "search" {
+		t.Errorf("expected aggregated stats with fastest 'search', got %+v", suite.Stats)
+	}
+	if suite.Timestamp.IsZero() {
+		t.Error("expected timestamp to be set")
+	}
+}
+
+func TestLoadReportSuite_Stored(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "benchflow.db")
+	viper.Set("storage.path", dbPath)
+	defer viper.Set("storage.path", nil)
+
+	store, err := openConfiguredStorage()
+	if err != nil {
+		t.Fatalf("openConfiguredStorage() error = %v", err)
+	}
+
+	id, err := store.SaveSuite(&aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "stored_bench", Language: "rust", Mean: 100 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Timestamp: time.Now(),
+	})
+	_ = store.Close()
+	if err != nil {
+		t.Fatalf("SaveSuite() error = %v", err)
+	}
+
+	for _, input := range []string{"latest", strconv.FormatInt(id, 10)} {
+		suite, err := loadReportSuite(input)
+		if err != nil {
+			t.Fatalf("loadReportSuite(%q) error = %v", input, err)
+		}
+		if len(suite.Results) != 1 || suite.Results[0].Name != "stored_bench" {
+			t.Errorf("loadReportSuite(%q) returned unexpected results: %+v", input, suite.Results)
+		}
+	}
+
+	if _, err := loadReportSuite("99"); err == nil {
+		t.Error("expected error for unknown suite ID")
+	}
+}
+
+func TestLoadReportSuite_InvalidInput(t *testing.T) {
+	_, err := loadReportSuite(filepath.Join(t.TempDir(), "missing.json"))
+	if err == nil {
+		t.Fatal("expected error for missing input")
+	}
+	if !strings.Contains(err.Error(), "input not found") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRenderSuiteReport_Formats(t *testing.T) {
+	suite, err := aggregator.NewAggregator().Aggregate(testParserSuite())
+	if err != nil {
+		t.Fatalf("Aggregate() error = %v", err)
+	}
+
+	tests := []struct {
+		format reporter.ReportFormat
+		want   string
+	}{
+		{format: reporter.FormatHTML, want: "<!DOCTYPE html>"},
+		{format: reporter.FormatJSON, want: `"results"`},
+		{format: reporter.FormatCSV, want: "Name,Language,Mean (ns)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			opts := &reporter.ReportOptions{Title: "Test", Format: tt.format, ShowDetails: true}
+			if err := renderSuiteReport(suite, opts, &buf); err != nil {
+				t.Fatalf("renderSuiteReport() error = %v", err)
+			}
+			if !strings.Contains(buf.String(), tt.want) {
+				t.Errorf("expected output to contain %q", tt.want)
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := renderSuiteReport(suite, &reporter.ReportOptions{Format: "pdf"}, &buf); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+// testParserSuite returns a small parsed suite for report tests
+func testParserSuite() *parser.BenchmarkSuite {
+	return &parser.BenchmarkSuite{
+		Language:  "go",
+		Timestamp: time.Now(),
+		Results: []*parser.BenchmarkResult{
+			{Name: "BenchmarkSort-8", Language: "go", Time: 1000 * time.Nanosecond, Iterations: 100},
+			{Name: "BenchmarkSearch-8", Language: "go", Time: 500 * time.Nanosecond, Iterations: 200},
+		},
+	}
+}
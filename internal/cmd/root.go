@@ -61,6 +61,9 @@ func initConfig() {
 		viper.SetConfigName("benchflow")
 	}
 
+	// Run reports have always used the dark theme
+	viper.SetDefault("output.dark_mode", true)
+
 	// Read in environment variables that match
 	viper.SetEnvPrefix("BENCHFLOW")
 	viper.AutomaticEnv()
@@ -53,9 +53,21 @@ func runBenchmarks(cmd *cobra.Command, args []string) error {
 	registry := executor.NewParserRegistry()
 	registry.RegisterParser("rust", parser.NewRustParser())
 	registry.RegisterParser("python", parser.NewPythonParser())
+	registry.RegisterParser("pyperf", parser.NewPyperfParser())
+	registry.RegisterParser("asv", parser.NewAsvParser())
+	registry.RegisterParser("criterion", parser.NewCriterionParser())
+	registry.RegisterParser("divan", parser.NewDivanParser())
+	registry.RegisterParser("iai-callgrind", parser.NewIaiCallgrindParser())
 	registry.RegisterParser("go", parser.NewGoParser())
+	registry.RegisterParser("cpp", parser.NewGoogleBenchmarkParser())
+	registry.RegisterParser("java", parser.NewJMHParser())
+	registry.RegisterParser("csharp", parser.NewBenchmarkDotNetParser())
 	registry.RegisterParser("nodejs", parser.NewNodeJSParser())
 	registry.RegisterParser("typescript", parser.NewTypeScriptParser())
+	registry.RegisterParser("vitest", parser.NewVitestParser())
+	registry.RegisterParser("mitata", parser.NewMitataParser())
+	registry.RegisterParser("deno", parser.NewDenoParser())
+	registry.RegisterParser("hyperfine", parser.NewHyperfineParser())
 
 	// Create execution config
 	execConfig := &executor.ExecutionConfig{
@@ -156,6 +168,26 @@ func runBenchmarks(cmd *cobra.Command, args []string) error {
 		fmt.Fprintf(os.Stderr, "\n")
 	}
 
+	// Persist results to storage
+	if viper.GetBool("storage.enabled") && successCount > 0 {
+		if storeErr := storeRunResults(results); storeErr != nil {
+			return fmt.Errorf("failed to store results: %w", storeErr)
+		}
+	}
+
+	// Write reports to the output directory
+	outputDir := viper.GetString("output.directory")
+	outputFormats := viper.GetStringSlice("output.formats")
+	if outputDir != "" && len(outputFormats) > 0 && successCount > 0 {
+		paths, reportErr := writeRunReports(results, outputDir, outputFormats, viper.GetBool("output.dark_mode"), time.Now())
+		for _, path := range paths {
+			fmt.Fprintf(os.Stderr, "📄 Report written to %s\n", path)
+		}
+		if reportErr != nil {
+			return fmt.Errorf("failed to write reports: %w", reportErr)
+		}
+	}
+
 	if err != nil {
 		return fmt.Errorf("batch execution failed: %w", err)
 	}
@@ -167,6 +199,29 @@ func runBenchmarks(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// storeRunResults saves successful results to the configured storage and applies retention
+func storeRunResults(results []*executor.ExecutionResult) error {
+	store, err := openConfiguredStorage()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	stored, err := persistResults(store, results)
+	for _, run := range stored {
+		fmt.Fprintf(os.Stderr, "💾 %s stored as suite %d (%d results)\n", run.Name, run.SuiteID, run.Results)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := applyRetention(store); err != nil {
+		return fmt.Errorf("failed to apply retention: %w", err)
+	}
+
+	return nil
+}
+
 // loadBenchmarkConfigs loads benchmark configurations from viper
 func loadBenchmarkConfigs(cmd *cobra.Command) ([]*executor.BenchmarkConfig, error) {
 	// Get benchmarks from config
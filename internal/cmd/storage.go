@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/aggregator"
+	"github.com/jpequegn/benchflow/internal/executor"
+	"github.com/jpequegn/benchflow/internal/storage"
+	"github.com/spf13/viper"
+)
+
+// defaultStoragePath is used when storage is enabled but no path is configured
+const defaultStoragePath = "./benchflow.db"
+
+// StoredRun describes a benchmark run persisted to storage
+type StoredRun struct {
+	Name    string // Benchmark config name
+	SuiteID int64  // ID assigned by storage
+	Results int    // Number of results stored
+}
+
+// openConfiguredStorage opens and initializes the SQLite database from the storage config
+func openConfiguredStorage() (*storage.SQLiteStorage, error) {
+	path := viper.GetString("storage.path")
+	if path == "" {
+		path = defaultStoragePath
+	}
+
+	store, err := storage.NewSQLiteStorage(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.Init(); err != nil {
+		_ = store.Close()
+		return nil, fmt.Errorf("failed to initialize storage at %s: %w", path, err)
+	}
+
+	return store, nil
+}
+
+// persistResults aggregates every successful execution result and saves it to storage
+func persistResults(store *storage.SQLiteStorage, results []*executor.ExecutionResult) ([]*StoredRun, error) {
+	agg := aggregator.NewAggregator()
+	stored := make([]*StoredRun, 0, len(results))
+
+	for _, result := range results {
+		if result.Error != nil || result.Suite == nil || len(result.Suite.Results) == 0 {
+			continue
+		}
+
+		aggregated, err := agg.Aggregate(result.Suite)
+		if err != nil {
+			return stored, fmt.Errorf("failed to aggregate %s: %w", result.Config.Name, err)
+		}
+
+		// Record which benchmark config produced this suite
+		metadata := make(map[string]string, len(aggregated.Metadata)+2)
+		for k, v := range aggregated.Metadata {
+			metadata[k] = v
+		}
+		metadata["benchmark"] = result.Config.Name
+		metadata["language"] = result.Config.Language
+		aggregated.Metadata = metadata
+		aggregated.Duration = result.Duration
+
+		suiteID, err := store.SaveSuite(aggregated)
+		if err != nil {
+			return stored, fmt.Errorf("failed to save %s: %w", result.Config.Name, err)
+		}
+
+		slog.Debug("Stored benchmark suite",
+			"benchmark", result.Config.Name,
+			"suite_id", suiteID,
+			"results", len(aggregated.Results))
+
+		stored = append(stored, &StoredRun{
+			Name:    result.Config.Name,
+			SuiteID: suiteID,
+			Results: len(aggregated.Results),
+		})
+	}
+
+	return stored, nil
+}
+
+// applyRetention removes stored suites older than the configured retention period
+func applyRetention(store *storage.SQLiteStorage) error {
+	retention := viper.GetString("storage.retention")
+	if retention == "" {
+		return nil
+	}
+
+	days, err := parseRetentionDays(retention)
+	if err != nil {
+		return err
+	}
+
+	return store.Cleanup(days)
+}
+
+// parseRetentionDays converts a retention setting into a number of days.
+// Accepts day ("90d") and week ("12w") suffixes as well as Go durations ("720h"),
+// which are rounded up to whole days.
+func parseRetentionDays(value string) (int, error) {
+	value = strings.TrimSpace(strings.ToLower(value))
+	if value == "" {
+		return 0, fmt.Errorf("retention cannot be empty")
+	}
+
+	var days int
+	switch {
+	case strings.HasSuffix(value, "d"):
+		n, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid retention %q: %w", value, err)
+		}
+		days = n
+	case strings.HasSuffix(value, "w"):
+		n, err := strconv.Atoi(strings.TrimSuffix(value, "w"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid retention %q: %w", value, err)
+		}
+		days = n * 7
+	default:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid retention %q (use e.g. 90d, 12w or 720h)", value)
+		}
+		day := 24 * time.Hour
+		days = int((d + day - 1) / day)
+	}
+
+	if days <= 0 {
+		return 0, fmt.Errorf("retention must be positive, got %q", value)
+	}
+
+	return days, nil
+}
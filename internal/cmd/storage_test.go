@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/executor"
+	"github.com/jpequegn/benchflow/internal/parser"
+	"github.com/jpequegn/benchflow/internal/storage"
+	"github.com/spf13/viper"
+)
+
+func TestParseRetentionDays(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{input: "90d", want: 90},
+		{input: " 30D ", want: 30},
+		{input: "2w", want: 14},
+		{input: "720h", want: 30},
+		{input: "25h", want: 2},
+		{input: "0d", wantErr: true},
+		{input: "-5d", wantErr: true},
+		{input: "", wantErr: true},
+		{input: "forever", wantErr: true},
+		{input: "xd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseRetentionDays(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRetentionDays(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseRetentionDays(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPersistResults(t *testing.T) {
+	store := setupCmdTestStorage(t)
+
+	results := []*executor.ExecutionResult{
+		{
+			Config: &executor.BenchmarkConfig{Name: "go-benchmarks", Language: "go"},
+			Suite: &parser.BenchmarkSuite{
+				Language:  "go",
+				Timestamp: time.Now(),
+				Results: []*parser.BenchmarkResult{
+					{Name: "BenchmarkSort-8", Language: "go", Time: 1000 * time.Nanosecond, Iterations: 100},
+					{Name: "BenchmarkSearch-8", Language: "go", Time: 500 * time.Nanosecond, Iterations: 200},
+				},
+			},
+			Duration: 2 * time.Second,
+		},
+		{
+			Config: &executor.BenchmarkConfig{Name: "broken", Language: "rust"},
+			Error:  errors.New("command failed"),
+		},
+	}
+
+	stored, err := persistResults(store, results)
+	if err != nil {
+		t.Fatalf("persistResults() error = %v", err)
+	}
+
+	if len(stored) != 1 {
+		t.Fatalf("expected 1 stored run, got %d", len(stored))
+	}
+	if stored[0].Name != "go-benchmarks" || stored[0].Results != 2 || stored[0].SuiteID <= 0 {
+		t.Errorf("unexpected stored run: %+v", stored[0])
+	}
+
+	latest, err := store.GetLatest()
+	if err != nil {
+		t.Fatalf("GetLatest() error = %v", err)
+	}
+	if latest == nil {
+		t.Fatal("expected stored suite")
+	}
+	if len(latest.Results) != 2 {
+		t.Errorf("expected 2 stored results, got %d", len(latest.Results))
+	}
+	if latest.Metadata["benchmark"] != "go-benchmarks" {
+		t.Errorf("expected benchmark metadata, got %v", latest.Metadata)
+	}
+	if latest.Duration != 2*time.Second {
+		t.Errorf("expected duration 2s, got %v", latest.Duration)
+	}
+}
+
+func TestApplyRetention(t *testing.T) {
+	store := setupCmdTestStorage(t)
+
+	viper.Set("storage.retention", "90d")
+	defer viper.Set("storage.retention", nil)
+
+	if err := applyRetention(store); err != nil {
+		t.Fatalf("applyRetention() error = %v", err)
+	}
+
+	viper.Set("storage.retention", "soon")
+	if err := applyRetention(store); err == nil {
+		t.Error("expected error for invalid retention")
+	}
+}
+
+// setupCmdTestStorage creates an initialized storage in a temporary directory
+func setupCmdTestStorage(t *testing.T) *storage.SQLiteStorage {
+	t.Helper()
+
+	store, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "benchflow.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	if err := store.Init(); err != nil {
+		t.Fatalf("failed to init storage: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
@@ -0,0 +1,122 @@
+package comparator
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	// DefaultBootstrapResamples is the default number of bootstrap resamples
+	DefaultBootstrapResamples = 2000
+
+	// DefaultBootstrapSeed is the default random seed, so repeated comparisons are reproducible
+	DefaultBootstrapSeed int64 = 1
+)
+
+// ConfidenceInterval is a two-sided interval estimate
+type ConfidenceInterval struct {
+	// Lower is the lower bound of the interval
+	Lower float64
+
+	// Upper is the upper bound of the interval
+	Upper float64
+
+	// Level is the confidence level of the interval (e.g., 0.95 for 95%)
+	Level float64
+}
+
+// Contains reports whether v lies within the interval
+func (ci ConfidenceInterval) Contains(v float64) bool {
+	return v >= ci.Lower && v <= ci.Upper
+}
+
+// BootstrapRatioCI estimates a confidence interval for mean(current) / mean(baseline)
+// using the percentile bootstrap. Both sides are resampled with replacement
+// independently. The same seed always produces the same interval.
+// Returns false when either side has fewer than two samples or resamples is not positive.
+func BootstrapRatioCI(baseline, current []float64, confidenceLevel float64, resamples int, seed int64) (ConfidenceInterval, bool) {
+	if len(baseline) < 2 || len(current) < 2 || resamples <= 0 {
+		return ConfidenceInterval{}, false
+	}
+	if confidenceLevel <= 0 || confidenceLevel >= 1 {
+		return ConfidenceInterval{}, false
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	ratios := make([]float64, 0, resamples)
+	for i := 0; i < resamples; i++ {
+		baseMean := resampleMean(rng, baseline)
+		if baseMean == 0 {
+			continue
+		}
+		ratios = append(ratios, resampleMean(rng, current)/baseMean)
+	}
+
+	if len(ratios) == 0 {
+		return ConfidenceInterval{}, false
+	}
+
+	sort.Float64s(ratios)
+
+	alpha := 1 - confidenceLevel
+	return ConfidenceInterval{
+		Lower: percentile(ratios, alpha/2),
+		Upper: percentile(ratios, 1-alpha/2),
+		Level: confidenceLevel,
+	}, true
+}
+
+// BootstrapMeanCI estimates a confidence interval for the mean of data using the
+// percentile bootstrap. The same seed always produces the same interval.
+// Returns false when data has fewer than two values or resamples is not positive.
+func BootstrapMeanCI(data []float64, confidenceLevel float64, resamples int, seed int64) (ConfidenceInterval, bool) {
+	if len(data) < 2 || resamples <= 0 {
+		return ConfidenceInterval{}, false
+	}
+	if confidenceLevel <= 0 || confidenceLevel >= 1 {
+		return ConfidenceInterval{}, false
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	means := make([]float64, resamples)
+	for i := range means {
+		means[i] = resampleMean(rng, data)
+	}
+	sort.Float64s(means)
+
+	alpha := 1 - confidenceLevel
+	return ConfidenceInterval{
+		Lower: percentile(means, alpha/2),
+		Upper: percentile(means, 1-alpha/2),
+		Level: confidenceLevel,
+	}, true
+}
+
+// resampleMean returns the mean of a resample of data drawn with replacement
+func resampleMean(rng *rand.Rand, data []float64) float64 {
+	sum := 0.0
+	for range data {
+		sum += data[rng.Intn(len(data))]
+	}
+	return sum / float64(len(data))
+}
+
+// percentile returns the p-th quantile (0 <= p <= 1) of sorted data using linear interpolation
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	frac := pos - float64(lower)
+	return sorted[lower]*(1-frac) + sorted[upper]*frac
+}
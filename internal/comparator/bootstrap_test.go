@@ -0,0 +1,154 @@
+package comparator
+
+import (
+	"testing"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestBootstrapRatioCI(t *testing.T) {
+	baseline := []float64{100, 102, 98, 101, 99, 100, 103, 97}
+	current := []float64{110, 112, 108, 111, 109, 110, 113, 107}
+
+	ci, ok := BootstrapRatioCI(baseline, current, 0.95, 2000, 1)
+	if !ok {
+		t.Fatal("BootstrapRatioCI() ok = false, want true")
+	}
+
+	if !ci.Contains(1.10) {
+		t.Errorf("interval [%v, %v] should contain the observed ratio 1.10", ci.Lower, ci.Upper)
+	}
+	if ci.Lower < 1.05 || ci.Upper > 1.15 {
+		t.Errorf("interval [%v, %v] is wider than expected", ci.Lower, ci.Upper)
+	}
+	if ci.Level != 0.95 {
+		t.Errorf("Level = %v, want 0.95", ci.Level)
+	}
+}
+
+func TestBootstrapRatioCI_Reproducible(t *testing.T) {
+	baseline := []float64{100, 120, 90, 105, 95}
+	current := []float64{104, 118, 99, 101, 110}
+
+	first, _ := BootstrapRatioCI(baseline, current, 0.95, 500, 42)
+	second, _ := BootstrapRatioCI(baseline, current, 0.95, 500, 42)
+	if first != second {
+		t.Errorf("same seed produced different intervals: %v vs %v", first, second)
+	}
+
+	other, _ := BootstrapRatioCI(baseline, current, 0.95, 500, 7)
+	if first == other {
+		t.Error("different seeds produced identical intervals")
+	}
+}
+
+func TestBootstrapRatioCI_InsufficientData(t *testing.T) {
+	tests := []struct {
+		name      string
+		baseline  []float64
+		current   []float64
+		resamples int
+	}{
+		{"single baseline sample", []float64{100}, []float64{100, 101}, 100},
+		{"no current samples", []float64{100, 101}, nil, 100},
+		{"disabled", []float64{100, 101}, []float64{100, 101}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := BootstrapRatioCI(tt.baseline, tt.current, 0.95, tt.resamples, 1); ok {
+				t.Error("BootstrapRatioCI() ok = true, want false")
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5}
+
+	if got := percentile(sorted, 0); got != 1 {
+		t.Errorf("percentile(0) = %v, want 1", got)
+	}
+	if got := percentile(sorted, 1); got != 5 {
+		t.Errorf("percentile(1) = %v, want 5", got)
+	}
+	if got := percentile(sorted, 0.5); got != 3 {
+		t.Errorf("percentile(0.5) = %v, want 3", got)
+	}
+	if got := percentile(sorted, 0.125); got != 1.5 {
+		t.Errorf("percentile(0.125) = %v, want 1.5", got)
+	}
+}
+
+func TestCompare_RegressionRequiresWholeInterval(t *testing.T) {
+	// Mean ratio is ~1.06 but the samples are too noisy for the interval to clear 1.05
+	noisy := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1060, Samples: durations(800, 1300, 900, 1250, 1050)},
+	}}
+	// Tight samples with the same ratio clear the threshold
+	tight := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1100, Samples: durations(1098, 1100, 1102, 1099, 1101)},
+	}}
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1000, Samples: durations(998, 1000, 1002, 999, 1001)},
+	}}
+
+	comp := NewBasicComparator()
+
+	noisyResult := comp.Compare(baseline, noisy).Benchmarks[0]
+	if noisyResult.RatioCI == nil {
+		t.Fatal("expected a ratio confidence interval")
+	}
+	if noisyResult.IsRegression {
+		t.Errorf("noisy IsRegression = true, want false (interval [%v, %v])", noisyResult.RatioCI.Lower, noisyResult.RatioCI.Upper)
+	}
+
+	tightResult := comp.Compare(baseline, tight).Benchmarks[0]
+	if !tightResult.IsRegression {
+		t.Error("tight IsRegression = false, want true")
+	}
+
+	lower, upper, ok := tightResult.DeltaCI()
+	if !ok || lower > 10 || upper < 10 {
+		t.Errorf("DeltaCI() = [%v, %v], want interval containing 10%%", lower, upper)
+	}
+}
+
+func TestCompare_BootstrapDisabled(t *testing.T) {
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1000, Samples: durations(998, 1000, 1002)},
+	}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1100, Samples: durations(1098, 1100, 1102)},
+	}}
+
+	comp := NewBasicComparator()
+	comp.BootstrapResamples = 0
+
+	bc := comp.Compare(baseline, current).Benchmarks[0]
+	if bc.RatioCI != nil {
+		t.Error("expected no interval when bootstrapping is disabled")
+	}
+	if !bc.IsRegression {
+		t.Error("expected point-estimate regression when bootstrapping is disabled")
+	}
+}
+
+func TestBootstrapMeanCI(t *testing.T) {
+	data := []float64{100, 102, 98, 101, 99, 100, 103, 97}
+
+	ci, ok := BootstrapMeanCI(data, 0.95, 2000, 1)
+	if !ok {
+		t.Fatal("BootstrapMeanCI() ok = false, want true")
+	}
+	if !ci.Contains(100) {
+		t.Errorf("interval [%v, %v] should contain the mean 100", ci.Lower, ci.Upper)
+	}
+	if ci.Lower < 97 || ci.Upper > 103 {
+		t.Errorf("interval [%v, %v] is wider than the data", ci.Lower, ci.Upper)
+	}
+
+	if _, ok := BootstrapMeanCI([]float64{100}, 0.95, 2000, 1); ok {
+		t.Error("BootstrapMeanCI(single value) ok = true, want false")
+	}
+}
@@ -3,6 +3,8 @@ package comparator
 import (
 	"crypto/md5"
 	"fmt"
+	"io"
+	"sort"
 	"sync"
 
 	"github.com/jpequegn/benchflow/internal/parser"
@@ -81,19 +83,53 @@ func (cc *CachedComparator) cacheKey(baseline, current *parser.BenchmarkSuite) s
 	// Use MD5 hash of suite contents for cache key
 	h := md5.New()
 
-	if baseline != nil {
-		for _, r := range baseline.Results {
-			_, _ = fmt.Fprintf(h, "%s:%s:%d", r.Name, r.Language, r.Time)
-		}
+	writeSuiteKey(h, "baseline", baseline)
+	writeSuiteKey(h, "current", current)
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// writeSuiteKey writes the environment metadata and results of a suite
+func writeSuiteKey(w io.Writer, label string, suite *parser.BenchmarkSuite) {
+	_, _ = fmt.Fprintf(w, "|%s|", label)
+	if suite == nil {
+		return
 	}
 
-	if current != nil {
-		for _, r := range current.Results {
-			_, _ = fmt.Fprintf(h, "%s:%s:%d", r.Name, r.Language, r.Time)
-		}
+	// Environment mismatches are part of the comparison
+	keys := make([]string, 0, len(suite.Metadata))
+	for key := range suite.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(w, "%s=%s;", key, suite.Metadata[key])
 	}
 
-	return fmt.Sprintf("%x", h.Sum(nil))
+	for _, r := range suite.Results {
+		writeResultKey(w, r)
+	}
+}
+
+// writeResultKey writes the fields of a result that affect its comparison
+func writeResultKey(w io.Writer, r *parser.BenchmarkResult) {
+	_, _ = fmt.Fprintf(w, "\n%s:%s:%d:%d:%d:%d", r.Name, r.Language, r.Time, r.StdDev, r.Iterations, r.SampleCount)
+	// Hash every sample value, so runs with the same number of samples still differ
+	for _, s := range r.Samples {
+		_, _ = fmt.Fprintf(w, ",%d", s)
+	}
+	if r.Memory != nil {
+		_, _ = fmt.Fprintf(w, ":%d:%d", r.Memory.BytesPerOp, r.Memory.AllocsPerOp)
+	}
+	if r.Throughput != nil {
+		_, _ = fmt.Fprintf(w, ":%g:%s", r.Throughput.Value, r.Throughput.Unit)
+	}
+	for _, m := range r.Metrics {
+		_, _ = fmt.Fprintf(w, ":%s:%s:%g:%t", m.Name, m.Unit, m.Value, m.HigherIsBetter)
+		for _, s := range m.Samples {
+			_, _ = fmt.Fprintf(w, ",%g", s)
+		}
+	}
 }
 
 // NewLRUCache creates a new LRU cache
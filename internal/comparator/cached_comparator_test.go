@@ -125,6 +125,42 @@ func TestCachedComparator_CacheMissDifferentInput(t *testing.T) {
 	}
 }
 
+func TestCachedComparator_CacheMissDifferentSamplesAndEnvironment(t *testing.T) {
+	cached := NewCachedComparator(NewBasicComparator(), 10)
+
+	suite := func(cpu string, samples ...time.Duration) *parser.BenchmarkSuite {
+		return &parser.BenchmarkSuite{
+			Metadata: map[string]string{parser.MetadataCPU: cpu},
+			Results: []*parser.BenchmarkResult{
+				{Name: "sort", Language: "go", Time: 1000 * time.Nanosecond, Samples: samples},
+			},
+		}
+	}
+
+	baseline := suite("Intel", 990, 1000, 1010)
+	if cached.Compare(baseline, suite("Intel", 1000, 1010, 1020)).Benchmarks[0].IsSignificant {
+		t.Fatal("Expected overlapping samples not to be significant")
+	}
+
+	// Same number of samples, different values
+	result := cached.Compare(baseline, suite("Intel", 2000, 2010, 2020))
+	if size, _ := cached.CacheStats(); size != 2 {
+		t.Errorf("Expected cache size 2 after different samples, got %d", size)
+	}
+	if !result.Benchmarks[0].IsSignificant {
+		t.Error("Expected the new samples to be compared, got a stale result")
+	}
+
+	// Same results, different environment
+	result = cached.Compare(baseline, suite("AMD", 2000, 2010, 2020))
+	if size, _ := cached.CacheStats(); size != 3 {
+		t.Errorf("Expected cache size 3 after a different environment, got %d", size)
+	}
+	if len(result.EnvironmentMismatches) != 1 {
+		t.Errorf("Expected 1 environment mismatch, got %+v", result.EnvironmentMismatches)
+	}
+}
+
 func TestCachedComparator_LRUEviction(t *testing.T) {
 	bc := NewBasicComparator()
 	cached := NewCachedComparator(bc, 3) // Small cache size
@@ -15,7 +15,7 @@ type Comparator interface {
 	// GetSignificance determines if the difference between two results is statistically significant
 	GetSignificance(baseline, current *parser.BenchmarkResult, confidenceLevel float64) (significant bool, pValue float64)
 
-	// CalculateConfidenceInterval calculates the confidence interval for benchmark results
+	// CalculateConfidenceInterval calculates a bootstrap confidence interval for the mean time of benchmark results
 	CalculateConfidenceInterval(results []*parser.BenchmarkResult, confidenceLevel float64) (lower, upper float64)
 }
 
@@ -33,6 +33,22 @@ type ComparisonResult struct {
 	// Improvements lists names of benchmarks that improved
 	Improvements []string
 
+	// Added lists current benchmarks with no baseline
+	Added []string
+
+	// Removed lists baseline benchmarks missing from the current suite
+	Removed []string
+
+	// Renamed lists baseline benchmarks matched to a current benchmark through the rename mapping
+	Renamed []Rename
+
+	// LanguageMismatches lists benchmarks skipped because their language changed
+	LanguageMismatches []LanguageMismatch
+
+	// EnvironmentMismatches lists environment properties (OS, architecture, CPU) that differ
+	// between the runs; results may not be comparable when any are present
+	EnvironmentMismatches []EnvironmentMismatch
+
 	// Statistics contains detailed statistics about the comparison
 	Statistics ComparisonStats
 }
@@ -54,23 +70,84 @@ type BenchmarkComparison struct {
 	// TimeDelta is the time change in percentage (negative = faster, positive = slower)
 	TimeDelta float64
 
-	// IsRegression indicates if this is a performance regression
+	// IsRegression indicates if this is a performance regression under the regression policy
 	IsRegression bool
 
+	// RegressionReason explains why the benchmark was or wasn't flagged as a regression
+	RegressionReason string
+
+	// ExceedsThreshold indicates the slowdown exceeds RegressionThreshold
+	// (for the whole confidence interval when one is available)
+	ExceedsThreshold bool
+
 	// IsSignificant indicates if the difference is statistically significant
 	IsSignificant bool
 
 	// ConfidenceLevel is the confidence level used (e.g., 0.95 for 95%)
 	ConfidenceLevel float64
 
-	// TTestPValue is the p-value from the t-test
-	TTestPValue float64
+	// Test is the significance test that was run (empty when there was not enough data)
+	Test StatisticalTest
+
+	// ThresholdFallback indicates the policy required a significance test but none
+	// could run, so the regression verdict used the threshold alone
+	ThresholdFallback bool
+
+	// TestStatistic is the t statistic (Welch) or U statistic (Mann–Whitney)
+	TestStatistic float64
+
+	// DegreesOfFreedom is the Welch–Satterthwaite degrees of freedom (Welch only)
+	DegreesOfFreedom float64
+
+	// PValue is the two-sided p-value from the significance test
+	PValue float64
+
+	// AdjustedPValue is PValue after multiple-comparison correction, used for IsSignificant
+	// (equal to PValue when no correction is configured)
+	AdjustedPValue float64
 
 	// EffectSize is Cohen's d effect size
 	EffectSize float64
 
+	// RatioCI is the bootstrap confidence interval for the current/baseline time ratio
+	// (nil when either side lacks raw samples or bootstrapping is disabled)
+	RatioCI *ConfidenceInterval
+
 	// RegressionThreshold is the threshold for regression detection
 	RegressionThreshold float64
+
+	// Throughput compares the native throughput (higher is better) when both
+	// sides report it in the same unit; the threshold check then uses it instead of time
+	Throughput *MetricComparison
+
+	// Metrics compares non-time metrics reported on both sides (e.g., allocs/op)
+	Metrics []*MetricComparison
+}
+
+// DeltaCI returns the bootstrap confidence interval for TimeDelta in percent.
+// ok is false when no interval is available.
+func (c *BenchmarkComparison) DeltaCI() (lower, upper float64, ok bool) {
+	if c.RatioCI == nil {
+		return 0, 0, false
+	}
+	return (c.RatioCI.Lower - 1) * 100, (c.RatioCI.Upper - 1) * 100, true
+}
+
+// Slower reports whether the current run is slower than the baseline,
+// judged by native throughput when it was compared and by time otherwise
+func (c *BenchmarkComparison) Slower() bool {
+	if c.Throughput != nil {
+		return c.Throughput.Current < c.Throughput.Baseline
+	}
+	return c.TimeDelta > 0
+}
+
+// Faster reports whether the current run is faster than the baseline
+func (c *BenchmarkComparison) Faster() bool {
+	if c.Throughput != nil {
+		return c.Throughput.Improved()
+	}
+	return c.TimeDelta < 0
 }
 
 // ComparisonSummary contains aggregate summary statistics
@@ -95,6 +172,10 @@ type ComparisonSummary struct {
 
 	// SignificantChanges is the count of statistically significant changes
 	SignificantChanges int
+
+	// ThresholdFallbacks is the count of comparisons judged by the threshold alone
+	// because no significance test could run
+	ThresholdFallbacks int
 }
 
 // ComparisonStats contains detailed statistical information
@@ -107,6 +188,27 @@ type ComparisonStats struct {
 
 	// RegressionThreshold is the threshold for regression detection
 	RegressionThreshold float64
+
+	// Test is the configured significance test
+	Test StatisticalTest
+
+	// Correction is the multiple-comparison correction applied to p-values
+	Correction Correction
+
+	// Policy is the regression policy used
+	Policy RegressionPolicy
+
+	// MinEffectSize is the minimum |Cohen's d| required to flag a regression (0 = disabled)
+	MinEffectSize float64
+
+	// BootstrapResamples is the number of bootstrap resamples (0 = disabled)
+	BootstrapResamples int
+
+	// BootstrapSeed is the random seed used for bootstrap resampling
+	BootstrapSeed int64
+
+	// MetricThresholds maps metric names to the regression thresholds in effect
+	MetricThresholds map[string]float64
 }
 
 // BasicComparator implements the Comparator interface
@@ -116,6 +218,32 @@ type BasicComparator struct {
 
 	// RegressionThreshold is the multiplier for regression detection (default: 1.05 = 5%)
 	RegressionThreshold float64
+
+	// Test is the significance test to use (default: Welch's t-test)
+	Test StatisticalTest
+
+	// Correction is the multiple-comparison correction applied across the suite (default: none)
+	Correction Correction
+
+	// Policy decides which evidence is required to flag a regression (default: both)
+	Policy RegressionPolicy
+
+	// MinEffectSize is the minimum |Cohen's d| required to flag a regression (default: 0 = disabled)
+	MinEffectSize float64
+
+	// BootstrapResamples is the number of bootstrap resamples used for the ratio
+	// confidence interval (default: 2000, 0 disables bootstrapping)
+	BootstrapResamples int
+
+	// BootstrapSeed is the random seed for bootstrap resampling (default: 1)
+	BootstrapSeed int64
+
+	// Renames maps baseline benchmark names to their current names (old -> new)
+	Renames map[string]string
+
+	// MetricThresholds overrides per-metric regression thresholds
+	// (default: allocs_per_op 1.0 = no increase, bytes_per_op 1.05)
+	MetricThresholds map[string]float64
 }
 
 // NewBasicComparator creates a new BasicComparator with default settings
@@ -123,53 +251,99 @@ func NewBasicComparator() *BasicComparator {
 	return &BasicComparator{
 		ConfidenceLevel:     0.95,
 		RegressionThreshold: 1.05,
+		Test:                TestWelch,
+		Correction:          CorrectionNone,
+		Policy:              PolicyBoth,
+		BootstrapResamples:  DefaultBootstrapResamples,
+		BootstrapSeed:       DefaultBootstrapSeed,
 	}
 }
 
 // Compare compares a baseline suite against a current suite
 func (bc *BasicComparator) Compare(baseline, current *parser.BenchmarkSuite) *ComparisonResult {
 	result := &ComparisonResult{
-		Benchmarks:   make([]*BenchmarkComparison, 0),
-		Regressions:  make([]string, 0),
-		Improvements: make([]string, 0),
+		Benchmarks:            make([]*BenchmarkComparison, 0),
+		Regressions:           make([]string, 0),
+		Improvements:          make([]string, 0),
+		Added:                 make([]string, 0),
+		Removed:               make([]string, 0),
+		Renamed:               make([]Rename, 0),
+		LanguageMismatches:    make([]LanguageMismatch, 0),
+		EnvironmentMismatches: make([]EnvironmentMismatch, 0),
 		Statistics: ComparisonStats{
 			ConfidenceLevel:     bc.ConfidenceLevel,
 			SignificanceLevel:   1 - bc.ConfidenceLevel,
 			RegressionThreshold: bc.RegressionThreshold,
+			Test:                bc.Test,
+			Correction:          bc.Correction,
+			Policy:              bc.Policy,
+			MinEffectSize:       bc.MinEffectSize,
+			BootstrapResamples:  bc.BootstrapResamples,
+			BootstrapSeed:       bc.BootstrapSeed,
+			MetricThresholds:    bc.effectiveMetricThresholds(),
 		},
 	}
 
-	if baseline == nil || current == nil || len(baseline.Results) == 0 || len(current.Results) == 0 {
+	if baseline == nil || current == nil {
 		return result
 	}
 
-	// Create a map of baseline results by name for quick lookup
+	// Warn when the runs were taken on different platforms or hardware
+	result.EnvironmentMismatches = compareEnvironment(baseline.Metadata, current.Metadata)
+
+	// Create a map of baseline results by current name for quick lookup
 	baselineMap := make(map[string]*parser.BenchmarkResult)
 	for _, br := range baseline.Results {
-		baselineMap[br.Name] = br
+		baselineMap[bc.currentName(br.Name)] = br
 	}
 
 	// Compare each current result with its baseline
+	matched := make(map[string]bool)
 	for _, currentResult := range current.Results {
 		baselineResult, found := baselineMap[currentResult.Name]
 		if !found {
-			// No baseline for this benchmark, skip it
+			// No baseline for this benchmark
+			result.Added = append(result.Added, currentResult.Name)
 			continue
 		}
+		matched[currentResult.Name] = true
 
 		if baselineResult.Language != currentResult.Language {
-			// Different languages, skip
+			// Different languages, not comparable
+			result.LanguageMismatches = append(result.LanguageMismatches, LanguageMismatch{
+				Name:             currentResult.Name,
+				BaselineLanguage: baselineResult.Language,
+				CurrentLanguage:  currentResult.Language,
+			})
 			continue
 		}
 
+		if baselineResult.Name != currentResult.Name {
+			result.Renamed = append(result.Renamed, Rename{Old: baselineResult.Name, New: currentResult.Name})
+		}
+
 		// Calculate comparison
 		comparison := bc.compareResults(baselineResult, currentResult)
 		result.Benchmarks = append(result.Benchmarks, comparison)
+	}
+
+	// Baseline benchmarks without a current counterpart were removed
+	for _, br := range baseline.Results {
+		if !matched[bc.currentName(br.Name)] {
+			result.Removed = append(result.Removed, br.Name)
+		}
+	}
+
+	// Adjust p-values for the number of benchmarks compared
+	bc.applyCorrection(result.Benchmarks)
+
+	// Apply the regression policy and track regressions and improvements
+	for _, comparison := range result.Benchmarks {
+		bc.classify(comparison)
 
-		// Track regressions and improvements
 		if comparison.IsRegression {
 			result.Regressions = append(result.Regressions, comparison.Name)
-		} else if comparison.TimeDelta < 0 {
+		} else if comparison.Faster() {
 			result.Improvements = append(result.Improvements, comparison.Name)
 		}
 	}
@@ -180,6 +354,14 @@ func (bc *BasicComparator) Compare(baseline, current *parser.BenchmarkSuite) *Co
 	return result
 }
 
+// currentName returns the name a baseline benchmark is expected to have in the current suite
+func (bc *BasicComparator) currentName(baselineName string) string {
+	if renamed, ok := bc.Renames[baselineName]; ok {
+		return renamed
+	}
+	return baselineName
+}
+
 // compareResults compares two individual benchmark results
 func (bc *BasicComparator) compareResults(baseline, current *parser.BenchmarkResult) *BenchmarkComparison {
 	comparison := &BenchmarkComparison{
@@ -198,18 +380,43 @@ func (bc *BasicComparator) compareResults(baseline, current *parser.BenchmarkRes
 		comparison.TimeDelta = ((float64(current.Time) - float64(baseline.Time)) / float64(baseline.Time)) * 100
 	}
 
-	// Determine if this is a regression based on threshold
-	timeRatio := float64(current.Time) / float64(baseline.Time)
-	comparison.IsRegression = timeRatio > bc.RegressionThreshold
+	// Estimate a confidence interval for the time ratio from raw samples
+	base := observe(baseline)
+	curr := observe(current)
+	if base.samples != nil && curr.samples != nil {
+		if ci, ok := BootstrapRatioCI(base.samples, curr.samples, bc.ConfidenceLevel, bc.BootstrapResamples, bc.BootstrapSeed); ok {
+			comparison.RatioCI = &ci
+		}
+	}
+
+	// Compare the native throughput unit when both sides report one
+	comparison.Throughput = bc.compareThroughput(baseline, current)
+
+	// Determine if the slowdown exceeds the threshold.
+	// With a confidence interval, the whole interval must exceed the threshold.
+	if comparison.RatioCI != nil {
+		comparison.ExceedsThreshold = comparison.RatioCI.Lower > bc.RegressionThreshold
+	} else if comparison.Throughput != nil {
+		comparison.ExceedsThreshold = comparison.Throughput.ExceedsThreshold
+	} else if baseline.Time > 0 {
+		timeRatio := float64(current.Time) / float64(baseline.Time)
+		comparison.ExceedsThreshold = timeRatio > bc.RegressionThreshold
+	}
 
 	// Calculate statistical significance
-	comparison.IsSignificant, comparison.TTestPValue = bc.GetSignificance(baseline, current, bc.ConfidenceLevel)
+	outcome := runTest(bc.Test, baseline, current)
+	comparison.Test = outcome.Test
+	comparison.TestStatistic = outcome.Statistic
+	comparison.DegreesOfFreedom = outcome.DegreesOfFreedom
+	comparison.PValue = outcome.PValue
+	comparison.AdjustedPValue = outcome.PValue
+	comparison.IsSignificant = outcome.Test != "" && outcome.PValue < 1-bc.ConfidenceLevel
 
 	// Calculate effect size
-	comparison.EffectSize = CohensDEffect(
-		[]float64{float64(baseline.Time)},
-		[]float64{float64(current.Time)},
-	)
+	comparison.EffectSize = effectSize(baseline, current)
+
+	// Compare memory and other non-time metrics
+	comparison.Metrics = bc.compareMetrics(baseline, current)
 
 	return comparison
 }
@@ -233,6 +440,9 @@ func (bc *BasicComparator) calculateSummary(result *ComparisonResult) Comparison
 		if comp.IsSignificant {
 			summary.SignificantChanges++
 		}
+		if comp.ThresholdFallback {
+			summary.ThresholdFallbacks++
+		}
 	}
 
 	if len(deltas) > 0 {
@@ -251,87 +461,45 @@ func (bc *BasicComparator) calculateSummary(result *ComparisonResult) Comparison
 	return summary
 }
 
-// GetSignificance determines if the difference between two results is statistically significant
-// Uses a simple t-test with the assumption that we have minimal data
+// GetSignificance determines if the difference between two results is statistically significant.
+// Uses Welch's t-test on raw samples, or on the reported mean, standard deviation and
+// sample count when samples are unavailable. Mann–Whitney U is used instead when
+// configured and both results carry raw samples. Results without enough data to test
+// are never significant.
 func (bc *BasicComparator) GetSignificance(baseline, current *parser.BenchmarkResult, confidenceLevel float64) (bool, float64) {
-	if baseline == nil || current == nil || baseline.Time == 0 || current.Time == 0 {
+	if baseline == nil || current == nil {
 		return false, 1.0
 	}
 
-	// For simplicity, we'll use a very basic approach:
-	// Calculate the relative difference and use standard deviation
-	baselineTime := float64(baseline.Time)
-	currentTime := float64(current.Time)
-	baselineStdDev := float64(baseline.StdDev)
-	currentStdDev := float64(current.StdDev)
-
-	// If we don't have standard deviations, estimate them
-	if baselineStdDev == 0 {
-		baselineStdDev = baselineTime * 0.05 // Assume 5% variance
-	}
-	if currentStdDev == 0 {
-		currentStdDev = currentTime * 0.05
-	}
-
-	// Calculate pooled standard deviation
-	pooledStdDev := math.Sqrt((baselineStdDev*baselineStdDev + currentStdDev*currentStdDev) / 2)
-
-	// Calculate t-statistic (simplified with n=1)
-	if pooledStdDev == 0 {
-		pooledStdDev = baselineTime * 0.01 // Avoid division by zero
+	outcome := runTest(bc.Test, baseline, current)
+	if outcome.Test == "" {
+		return false, outcome.PValue
 	}
 
-	tStat := (currentTime - baselineTime) / pooledStdDev
-
-	// Approximate p-value from t-statistic
-	// For practical purposes with small sample sizes, we use a simple threshold
-	pValue := 2 * (1 - normalCDF(math.Abs(tStat)))
-
-	// Determine significance at the given confidence level
 	alpha := 1 - confidenceLevel
-	isSignificant := pValue < alpha
-
-	return isSignificant, pValue
+	return outcome.PValue < alpha, outcome.PValue
 }
 
-// CalculateConfidenceInterval calculates the confidence interval for benchmark results
+// CalculateConfidenceInterval calculates a bootstrap confidence interval for the mean
+// time of benchmark results, in nanoseconds, using the comparator's resamples and seed.
+// The interval collapses to the mean when there are too few results to resample
+// or bootstrapping is disabled.
 func (bc *BasicComparator) CalculateConfidenceInterval(results []*parser.BenchmarkResult, confidenceLevel float64) (lower, upper float64) {
 	if len(results) == 0 {
 		return 0, 0
 	}
 
-	// Calculate mean
-	sum := 0.0
-	for _, r := range results {
-		sum += float64(r.Time)
-	}
-	mean := sum / float64(len(results))
-
-	// Calculate standard deviation
-	varianceSum := 0.0
-	for _, r := range results {
-		diff := float64(r.Time) - mean
-		varianceSum += diff * diff
+	times := make([]float64, len(results))
+	for i, r := range results {
+		times[i] = float64(r.Time)
 	}
-	stdDev := math.Sqrt(varianceSum / float64(len(results)-1))
 
-	// Calculate confidence interval using t-distribution approximation
-	// For small samples, use a simple z-score approximation
-	zScore := 1.96 // 95% confidence
-	if confidenceLevel == 0.99 {
-		zScore = 2.576 // 99% confidence
+	ci, ok := BootstrapMeanCI(times, confidenceLevel, bc.BootstrapResamples, bc.BootstrapSeed)
+	if !ok {
+		mean := calculateMean(times)
+		return mean, mean
 	}
-
-	marginOfError := zScore * (stdDev / math.Sqrt(float64(len(results))))
-	lower = mean - marginOfError
-	upper = mean + marginOfError
-
-	// Ensure lower bound is not negative
-	if lower < 0 {
-		lower = 0
-	}
-
-	return lower, upper
+	return ci.Lower, ci.Upper
 }
 
 // normalCDF approximates the cumulative distribution function of the standard normal distribution
@@ -352,14 +352,16 @@ func TestGetSignificance(t *testing.T) {
 	comp := NewBasicComparator()
 
 	baseline := &parser.BenchmarkResult{
-		Time:   1000 * time.Nanosecond,
-		StdDev: 10 * time.Nanosecond,
+		Time:        1000 * time.Nanosecond,
+		SampleCount: 30,
+		StdDev:      10 * time.Nanosecond,
 	}
 
 	// Test case 1: Very different (should be significant)
 	current := &parser.BenchmarkResult{
-		Time:   2000 * time.Nanosecond,
-		StdDev: 10 * time.Nanosecond,
+		Time:        2000 * time.Nanosecond,
+		SampleCount: 30,
+		StdDev:      10 * time.Nanosecond,
 	}
 
 	significant, pValue := comp.GetSignificance(baseline, current, 0.95)
@@ -372,8 +374,9 @@ func TestGetSignificance(t *testing.T) {
 
 	// Test case 2: Very similar (should not be significant)
 	current2 := &parser.BenchmarkResult{
-		Time:   1010 * time.Nanosecond,
-		StdDev: 50 * time.Nanosecond,
+		Time:        1010 * time.Nanosecond,
+		SampleCount: 30,
+		StdDev:      50 * time.Nanosecond,
 	}
 
 	significant2, pValue2 := comp.GetSignificance(baseline, current2, 0.95)
@@ -417,6 +420,28 @@ func TestCalculateConfidenceInterval(t *testing.T) {
 	}
 }
 
+func TestCalculateConfidenceInterval_SingleResult(t *testing.T) {
+	comp := NewBasicComparator()
+
+	lower, upper := comp.CalculateConfidenceInterval([]*parser.BenchmarkResult{{Time: 1000}}, 0.95)
+	if lower != 1000 || upper != 1000 {
+		t.Errorf("CalculateConfidenceInterval(single) = (%v, %v), want (1000, 1000)", lower, upper)
+	}
+}
+
+func TestCompare_ZeroBaselineTime(t *testing.T) {
+	comp := NewBasicComparator()
+	comp.Policy = PolicyThreshold
+
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{{Name: "noop", Time: 0}}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{{Name: "noop", Time: 5}}}
+
+	bc := comp.Compare(baseline, current).Benchmarks[0]
+	if bc.ExceedsThreshold || bc.IsRegression {
+		t.Errorf("ExceedsThreshold = %v, IsRegression = %v, want false for a zero baseline", bc.ExceedsThreshold, bc.IsRegression)
+	}
+}
+
 func TestCalculateConfidenceInterval_EmptyInput(t *testing.T) {
 	comp := NewBasicComparator()
 
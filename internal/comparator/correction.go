@@ -0,0 +1,107 @@
+package comparator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Correction identifies a multiple-comparison correction applied to p-values
+type Correction string
+
+const (
+	// CorrectionNone leaves p-values unadjusted
+	CorrectionNone Correction = "none"
+
+	// CorrectionBenjaminiHochberg controls the false discovery rate (FDR)
+	CorrectionBenjaminiHochberg Correction = "bh"
+
+	// CorrectionHolm controls the family-wise error rate with the Holm–Bonferroni step-down method
+	CorrectionHolm Correction = "holm"
+)
+
+// ParseCorrection parses a multiple-comparison correction name
+func ParseCorrection(name string) (Correction, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "none":
+		return CorrectionNone, nil
+	case "bh", "fdr", "benjamini-hochberg":
+		return CorrectionBenjaminiHochberg, nil
+	case "holm", "holm-bonferroni":
+		return CorrectionHolm, nil
+	default:
+		return "", fmt.Errorf("unknown correction: %s (must be none, bh, or holm)", name)
+	}
+}
+
+// AdjustPValues returns p-values adjusted for multiple comparisons.
+// The result is in the same order as the input and every value is capped at 1.
+func AdjustPValues(pValues []float64, correction Correction) []float64 {
+	adjusted := make([]float64, len(pValues))
+	copy(adjusted, pValues)
+
+	m := len(pValues)
+	if m <= 1 {
+		return adjusted
+	}
+
+	// Indices ordered by ascending p-value
+	order := make([]int, m)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return pValues[order[i]] < pValues[order[j]]
+	})
+
+	switch correction {
+	case CorrectionBenjaminiHochberg:
+		// Step-up: p(i) * m / i, made monotone from the largest p-value down
+		running := 1.0
+		for rank := m; rank >= 1; rank-- {
+			idx := order[rank-1]
+			value := pValues[idx] * (float64(m) / float64(rank))
+			if value < running {
+				running = value
+			}
+			adjusted[idx] = running
+		}
+
+	case CorrectionHolm:
+		// Step-down: p(i) * (m - i + 1), made monotone from the smallest p-value up
+		running := 0.0
+		for rank := 1; rank <= m; rank++ {
+			idx := order[rank-1]
+			value := pValues[idx] * float64(m-rank+1)
+			if value > 1 {
+				value = 1
+			}
+			if value > running {
+				running = value
+			}
+			adjusted[idx] = running
+		}
+	}
+
+	return adjusted
+}
+
+// applyCorrection adjusts the p-values of all tested comparisons as one family
+// and updates their significance. Comparisons without a test are left untouched.
+func (bc *BasicComparator) applyCorrection(comparisons []*BenchmarkComparison) {
+	tested := make([]*BenchmarkComparison, 0, len(comparisons))
+	pValues := make([]float64, 0, len(comparisons))
+	for _, comp := range comparisons {
+		if comp.Test == "" {
+			continue
+		}
+		tested = append(tested, comp)
+		pValues = append(pValues, comp.PValue)
+	}
+
+	alpha := 1 - bc.ConfidenceLevel
+	for i, p := range AdjustPValues(pValues, bc.Correction) {
+		tested[i].AdjustedPValue = p
+		tested[i].IsSignificant = p < alpha
+	}
+}
@@ -0,0 +1,124 @@
+package comparator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestAdjustPValues(t *testing.T) {
+	pValues := []float64{0.01, 0.04, 0.03, 0.005}
+
+	tests := []struct {
+		correction Correction
+		want       []float64
+	}{
+		{CorrectionNone, []float64{0.01, 0.04, 0.03, 0.005}},
+		{CorrectionBenjaminiHochberg, []float64{0.02, 0.04, 0.04, 0.02}},
+		{CorrectionHolm, []float64{0.03, 0.06, 0.06, 0.02}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.correction), func(t *testing.T) {
+			got := AdjustPValues(pValues, tt.correction)
+			for i := range tt.want {
+				if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Errorf("adjusted[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+
+	// Input must not be modified
+	if pValues[0] != 0.01 {
+		t.Error("AdjustPValues modified its input")
+	}
+}
+
+func TestAdjustPValues_CappedAtOne(t *testing.T) {
+	for _, correction := range []Correction{CorrectionBenjaminiHochberg, CorrectionHolm} {
+		for i, p := range AdjustPValues([]float64{0.6, 0.9, 0.4}, correction) {
+			if p > 1 {
+				t.Errorf("%s: adjusted[%d] = %v, want <= 1", correction, i, p)
+			}
+		}
+	}
+}
+
+func TestParseCorrection(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Correction
+		wantErr bool
+	}{
+		{"", CorrectionNone, false},
+		{"none", CorrectionNone, false},
+		{"BH", CorrectionBenjaminiHochberg, false},
+		{"fdr", CorrectionBenjaminiHochberg, false},
+		{"holm-bonferroni", CorrectionHolm, false},
+		{"bonferroni", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseCorrection(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseCorrection(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseCorrection(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestCompare_WithCorrection(t *testing.T) {
+	baseline := &parser.BenchmarkSuite{}
+	current := &parser.BenchmarkSuite{}
+
+	// One large change, one borderline change (p ~ 0.03) and eight noise-level changes
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("bench_%d", i)
+		currentTime := time.Duration(1000+i) * time.Nanosecond
+		switch i {
+		case 0:
+			currentTime = 1500 * time.Nanosecond
+		case 1:
+			currentTime = 1057 * time.Nanosecond
+		}
+		baseline.Results = append(baseline.Results, &parser.BenchmarkResult{
+			Name: name, Time: 1000 * time.Nanosecond, StdDev: 100 * time.Nanosecond, SampleCount: 30,
+		})
+		current.Results = append(current.Results, &parser.BenchmarkResult{
+			Name: name, Time: currentTime, StdDev: 100 * time.Nanosecond, SampleCount: 30,
+		})
+	}
+
+	uncorrected := NewBasicComparator().Compare(baseline, current)
+	if uncorrected.Summary.SignificantChanges != 2 {
+		t.Fatalf("uncorrected SignificantChanges = %d, want 2", uncorrected.Summary.SignificantChanges)
+	}
+
+	for _, correction := range []Correction{CorrectionBenjaminiHochberg, CorrectionHolm} {
+		comp := NewBasicComparator()
+		comp.Correction = correction
+
+		result := comp.Compare(baseline, current)
+		if result.Statistics.Correction != correction {
+			t.Errorf("%s: Statistics.Correction = %q", correction, result.Statistics.Correction)
+		}
+		if result.Summary.SignificantChanges != 1 {
+			t.Errorf("%s: SignificantChanges = %d, want 1", correction, result.Summary.SignificantChanges)
+		}
+		for _, bc := range result.Benchmarks {
+			if bc.AdjustedPValue < bc.PValue {
+				t.Errorf("%s: %s adjusted p %v < raw p %v", correction, bc.Name, bc.AdjustedPValue, bc.PValue)
+			}
+		}
+		if !result.Benchmarks[0].IsSignificant {
+			t.Errorf("%s: expected the large change to stay significant", correction)
+		}
+	}
+}
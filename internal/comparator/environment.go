@@ -0,0 +1,40 @@
+package comparator
+
+import (
+	"fmt"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+// EnvironmentMismatch records an environment property that differs between the baseline and current runs
+type EnvironmentMismatch struct {
+	// Key is the suite metadata key (e.g., "cpu", "goarch")
+	Key string
+
+	// Baseline is the value recorded for the baseline run
+	Baseline string
+
+	// Current is the value recorded for the current run
+	Current string
+}
+
+// String describes the mismatch, e.g. `cpu differs: "Apple M1" → "Apple M2"`
+func (m EnvironmentMismatch) String() string {
+	return fmt.Sprintf("%s differs: %q → %q", m.Key, m.Baseline, m.Current)
+}
+
+// environmentKeys are the suite metadata keys checked between runs. Timings taken on
+// a different OS, architecture or CPU can differ for reasons unrelated to the code.
+var environmentKeys = []string{parser.MetadataGOOS, parser.MetadataGOARCH, parser.MetadataCPU}
+
+// compareEnvironment returns the environment properties both runs recorded with different values
+func compareEnvironment(baseline, current map[string]string) []EnvironmentMismatch {
+	mismatches := make([]EnvironmentMismatch, 0)
+	for _, key := range environmentKeys {
+		b, c := baseline[key], current[key]
+		if b != "" && c != "" && b != c {
+			mismatches = append(mismatches, EnvironmentMismatch{Key: key, Baseline: b, Current: c})
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,70 @@
+package comparator
+
+import (
+	"testing"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestCompare_EnvironmentMismatches(t *testing.T) {
+	suite := func(metadata map[string]string) *parser.BenchmarkSuite {
+		return &parser.BenchmarkSuite{
+			Results:  []*parser.BenchmarkResult{{Name: "BenchmarkSort-8", Language: "go", Time: 1000}},
+			Metadata: metadata,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		baseline map[string]string
+		current  map[string]string
+		want     []EnvironmentMismatch
+	}{
+		{
+			name:     "same environment",
+			baseline: map[string]string{"goos": "linux", "goarch": "amd64", "cpu": "Intel Xeon"},
+			current:  map[string]string{"goos": "linux", "goarch": "amd64", "cpu": "Intel Xeon"},
+		},
+		{
+			name:     "different cpu and architecture",
+			baseline: map[string]string{"goos": "darwin", "goarch": "amd64", "cpu": "Intel Core i9"},
+			current:  map[string]string{"goos": "darwin", "goarch": "arm64", "cpu": "Apple M1"},
+			want: []EnvironmentMismatch{
+				{Key: "goarch", Baseline: "amd64", Current: "arm64"},
+				{Key: "cpu", Baseline: "Intel Core i9", Current: "Apple M1"},
+			},
+		},
+		{
+			name:     "unknown on one side",
+			baseline: map[string]string{"cpu": "Intel Xeon"},
+			current:  nil,
+		},
+		{
+			name:     "packages are not environment",
+			baseline: map[string]string{"package": "example.com/a"},
+			current:  map[string]string{"package": "example.com/b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewBasicComparator().Compare(suite(tt.baseline), suite(tt.current))
+
+			if len(result.EnvironmentMismatches) != len(tt.want) {
+				t.Fatalf("EnvironmentMismatches = %v, want %v", result.EnvironmentMismatches, tt.want)
+			}
+			for i, want := range tt.want {
+				if result.EnvironmentMismatches[i] != want {
+					t.Errorf("EnvironmentMismatches[%d] = %+v, want %+v", i, result.EnvironmentMismatches[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestEnvironmentMismatch_String(t *testing.T) {
+	m := EnvironmentMismatch{Key: "cpu", Baseline: "Apple M1", Current: "Apple M2"}
+	if got, want := m.String(), `cpu differs: "Apple M1" → "Apple M2"`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,319 @@
+package comparator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+const (
+	// MetricBytesPerOp is bytes allocated per operation (Go B/op)
+	MetricBytesPerOp = parser.MetricBytesPerOp
+
+	// MetricAllocsPerOp is heap allocations per operation (Go allocs/op)
+	MetricAllocsPerOp = parser.MetricAllocsPerOp
+
+	// MetricThroughput is the benchmark's native throughput (e.g., ops/s, MB/s)
+	MetricThroughput = parser.MetricThroughput
+
+	// MetricInstructions is an instruction count (e.g., iai-callgrind)
+	MetricInstructions = parser.MetricInstructions
+)
+
+// Direction states whether a metric improves by going down or up
+type Direction string
+
+const (
+	// LowerIsBetter is used for time, bytes and allocations
+	LowerIsBetter Direction = "lower"
+
+	// HigherIsBetter is used for throughput (ops/s, MB/s)
+	HigherIsBetter Direction = "higher"
+)
+
+// deterministicMetrics are metrics without measurement noise, even when the source
+// doesn't mark them as deterministic
+var deterministicMetrics = map[string]bool{
+	MetricBytesPerOp:   true,
+	MetricAllocsPerOp:  true,
+	MetricInstructions: true,
+}
+
+// DeterministicMetricThreshold is the default threshold of deterministic metrics
+// without a default of their own (e.g., simulated cache hits or estimated cycles):
+// they don't suffer timing noise, so only 0.1% growth is allowed
+const DeterministicMetricThreshold = 1.001
+
+// DefaultMetricThresholds returns the default per-metric regression thresholds.
+// Allocation counts are deterministic, so any increase is a regression. Instruction
+// counts don't suffer timing noise, so they only allow 0.1% growth.
+// Other deterministic metrics use DeterministicMetricThreshold, and the rest RegressionThreshold.
+func DefaultMetricThresholds() map[string]float64 {
+	return map[string]float64{
+		MetricBytesPerOp:   1.05,
+		MetricAllocsPerOp:  1.0,
+		MetricInstructions: 1.001,
+	}
+}
+
+// MetricComparison represents the comparison of one non-time metric
+type MetricComparison struct {
+	// Name is the metric name (e.g., "allocs_per_op")
+	Name string
+
+	// Unit is the display unit (e.g., "allocs/op")
+	Unit string
+
+	// Baseline is the baseline value
+	Baseline float64
+
+	// Current is the current value
+	Current float64
+
+	// DeltaPercent is the change in percentage (positive = increase)
+	DeltaPercent float64
+
+	// Direction states whether lower or higher values are better
+	Direction Direction
+
+	// Threshold is the multiplier by which the metric may worsen before it is a regression
+	// (1.0 = no change for the worse allowed)
+	Threshold float64
+
+	// ExceedsThreshold indicates the metric worsened beyond its threshold
+	ExceedsThreshold bool
+
+	// Deterministic indicates the metric has no measurement noise (e.g., allocation or
+	// instruction counts), so the threshold alone decides whether it regressed
+	Deterministic bool
+
+	// Test is the significance test run on the metric's samples (empty without samples on both sides)
+	Test StatisticalTest
+
+	// PValue is the two-sided p-value from Test (1 when no test was run)
+	PValue float64
+
+	// IsSignificant indicates the change is statistically significant
+	IsSignificant bool
+
+	// IsRegression indicates the metric regressed under the regression policy
+	IsRegression bool
+}
+
+// Improved reports whether the metric moved in its better direction
+func (m *MetricComparison) Improved() bool {
+	if m.Direction == HigherIsBetter {
+		return m.Current > m.Baseline
+	}
+	return m.Current < m.Baseline
+}
+
+// Worsened reports whether the metric moved in its worse direction
+func (m *MetricComparison) Worsened() bool {
+	if m.Direction == HigherIsBetter {
+		return m.Current < m.Baseline
+	}
+	return m.Current > m.Baseline
+}
+
+// ParseMetricThreshold parses a "metric=ratio" threshold, e.g. "allocs_per_op=1.0".
+// Any metric name is accepted; Go's "B/op" and "allocs/op" are mapped to the standard names.
+func ParseMetricThreshold(spec string) (string, float64, error) {
+	parts := strings.SplitN(spec, "=", 2)
+	if len(parts) != 2 {
+		return "", 0, fmt.Errorf("invalid metric threshold %q (expected metric=ratio)", spec)
+	}
+
+	name, err := parseMetricName(parts[0])
+	if err != nil {
+		return "", 0, err
+	}
+
+	threshold, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid threshold for %s: %w", name, err)
+	}
+	if threshold < 1.0 {
+		return "", 0, fmt.Errorf("threshold for %s must be at least 1.0, got %.2f", name, threshold)
+	}
+
+	return name, threshold, nil
+}
+
+// parseMetricName normalizes a metric name, accepting the units Go prints
+func parseMetricName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	switch strings.ToLower(name) {
+	case "":
+		return "", fmt.Errorf("metric name must not be empty")
+	case MetricBytesPerOp, "bytes", "b/op":
+		return MetricBytesPerOp, nil
+	case MetricAllocsPerOp, "allocs", "allocs/op":
+		return MetricAllocsPerOp, nil
+	default:
+		return name, nil
+	}
+}
+
+// metricThreshold returns the configured threshold for a metric, falling back to
+// the defaults, then to DeterministicMetricThreshold for deterministic metrics and
+// to RegressionThreshold for the rest
+func (bc *BasicComparator) metricThreshold(name string, deterministic bool) float64 {
+	if threshold, ok := bc.MetricThresholds[name]; ok {
+		return threshold
+	}
+	if threshold, ok := DefaultMetricThresholds()[name]; ok {
+		return threshold
+	}
+	if deterministic {
+		return DeterministicMetricThreshold
+	}
+	return bc.RegressionThreshold
+}
+
+// effectiveMetricThresholds returns the defaults merged with any configured overrides
+func (bc *BasicComparator) effectiveMetricThresholds() map[string]float64 {
+	thresholds := DefaultMetricThresholds()
+	for name, threshold := range bc.MetricThresholds {
+		thresholds[name] = threshold
+	}
+	return thresholds
+}
+
+// compareMetrics compares the named metrics both results report in the same unit,
+// except time and throughput, which are compared separately, and applies the
+// regression policy to each
+func (bc *BasicComparator) compareMetrics(baseline, current *parser.BenchmarkResult) []*MetricComparison {
+	baselineMetrics := make(map[string]parser.Metric)
+	for _, m := range baseline.AllMetrics() {
+		baselineMetrics[m.Name] = m
+	}
+
+	var metrics []*MetricComparison
+	for _, m := range current.AllMetrics() {
+		if m.Name == parser.MetricTimePerOp || m.Name == MetricThroughput {
+			continue
+		}
+		b, ok := baselineMetrics[m.Name]
+		if !ok || b.Unit != m.Unit {
+			continue
+		}
+
+		direction := LowerIsBetter
+		if m.HigherIsBetter {
+			direction = HigherIsBetter
+		}
+		deterministic := m.Deterministic || b.Deterministic || deterministicMetrics[m.Name]
+		mc := compareMetric(m.Name, m.Unit, direction, bc.metricThreshold(m.Name, deterministic), b.Value, m.Value)
+		mc.Deterministic = deterministic
+		bc.applyMetricPolicy(mc, b.Samples, m.Samples)
+		metrics = append(metrics, mc)
+	}
+	return metrics
+}
+
+// applyMetricPolicy decides whether a metric regressed. Deterministic metrics are
+// judged by their threshold alone. Noisy metrics (e.g., CPU time, latency percentiles)
+// are tested on their samples like time; without samples on both sides they can't be
+// told apart from noise, so they only fail under the threshold policy.
+func (bc *BasicComparator) applyMetricPolicy(m *MetricComparison, baseline, current []float64) {
+	if len(baseline) >= 2 && len(current) >= 2 {
+		if bc.Test == TestMannWhitney {
+			m.Test = TestMannWhitney
+			_, m.PValue = MannWhitneyUTest(baseline, current)
+		} else {
+			m.Test = TestWelch
+			_, _, m.PValue = WelchTTest(baseline, current)
+		}
+		m.IsSignificant = m.PValue < 1-bc.ConfidenceLevel
+	}
+
+	switch {
+	case m.Deterministic || bc.Policy == PolicyThreshold:
+		m.IsRegression = m.ExceedsThreshold
+	case m.Test == "":
+		m.IsRegression = false
+	case bc.Policy == PolicySignificance:
+		m.IsRegression = m.Worsened() && m.IsSignificant
+	default:
+		m.IsRegression = m.ExceedsThreshold && m.IsSignificant
+	}
+}
+
+// compareThroughput compares the native throughput of two results.
+// It returns nil unless both report a positive throughput in the same unit.
+func (bc *BasicComparator) compareThroughput(baseline, current *parser.BenchmarkResult) *MetricComparison {
+	if baseline.Throughput == nil || current.Throughput == nil {
+		return nil
+	}
+	if baseline.Throughput.Unit != current.Throughput.Unit || baseline.Throughput.Value <= 0 {
+		return nil
+	}
+
+	return compareMetric(MetricThroughput, current.Throughput.Unit, HigherIsBetter, bc.RegressionThreshold,
+		baseline.Throughput.Value, current.Throughput.Value)
+}
+
+// compareMetric compares a single metric against its threshold in the given direction.
+// IsRegression starts as the threshold verdict.
+func compareMetric(name, unit string, direction Direction, threshold, baseline, current float64) *MetricComparison {
+	m := &MetricComparison{
+		Name:      name,
+		Unit:      unit,
+		Baseline:  baseline,
+		Current:   current,
+		Direction: direction,
+		Threshold: threshold,
+		PValue:    1,
+	}
+
+	if baseline != 0 {
+		m.DeltaPercent = (current - baseline) / baseline * 100
+	} else if current != 0 {
+		// Anything from zero is an unbounded increase
+		m.DeltaPercent = math.Inf(1)
+	}
+
+	// Ratio by which the metric got worse (> 1 = worse)
+	worse, better := current, baseline
+	if direction == HigherIsBetter {
+		worse, better = baseline, current
+	}
+	switch {
+	case better != 0:
+		m.ExceedsThreshold = worse/better > threshold
+	case worse > 0:
+		m.ExceedsThreshold = true
+	}
+	m.IsRegression = m.ExceedsThreshold
+
+	return m
+}
+
+// metricRegressionReasons describes every metric that regressed
+func metricRegressionReasons(metrics []*MetricComparison) []string {
+	var reasons []string
+	for _, m := range metrics {
+		if !m.IsRegression {
+			continue
+		}
+		var evidence []string
+		if m.ExceedsThreshold {
+			evidence = append(evidence, fmt.Sprintf("exceeds %s threshold", thresholdLabel(m.Threshold)))
+		}
+		if m.IsSignificant {
+			evidence = append(evidence, fmt.Sprintf("significant (p=%.4f)", m.PValue))
+		}
+		reasons = append(reasons, fmt.Sprintf("%s %s → %s %s",
+			m.Unit, FormatMetricValue(m.Baseline), FormatMetricValue(m.Current), strings.Join(evidence, ", ")))
+	}
+	return reasons
+}
+
+// FormatMetricValue formats a metric value without trailing zeros
+func FormatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,422 @@
+package comparator
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestParseMetricThreshold(t *testing.T) {
+	tests := []struct {
+		input     string
+		name      string
+		threshold float64
+		wantErr   bool
+	}{
+		{"allocs_per_op=1.0", MetricAllocsPerOp, 1.0, false},
+		{"B/op=1.10", MetricBytesPerOp, 1.10, false},
+		{" allocs = 1.5 ", MetricAllocsPerOp, 1.5, false},
+		{"allocs_per_op", "", 0, true},
+		{"instructions=1.0", "instructions", 1.0, false},
+		{"=1.0", "", 0, true},
+		{"bytes_per_op=fast", "", 0, true},
+		{"bytes_per_op=0.9", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		name, threshold, err := ParseMetricThreshold(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseMetricThreshold(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if name != tt.name || threshold != tt.threshold {
+			t.Errorf("ParseMetricThreshold(%q) = %q, %v, want %q, %v", tt.input, name, threshold, tt.name, tt.threshold)
+		}
+	}
+}
+
+func memorySuites(baseline, current *parser.MemoryStats) (*parser.BenchmarkSuite, *parser.BenchmarkSuite) {
+	// Identical, tightly-measured timings so only memory can regress
+	return &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "encode", Language: "go", Time: 1000 * time.Nanosecond, StdDev: 10, SampleCount: 20, Memory: baseline},
+	}}, &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "encode", Language: "go", Time: 1000 * time.Nanosecond, StdDev: 10, SampleCount: 20, Memory: current},
+	}}
+}
+
+func TestCompare_AllocRegressionWithUnchangedTime(t *testing.T) {
+	baseline, current := memorySuites(
+		&parser.MemoryStats{BytesPerOp: 1024, AllocsPerOp: 10},
+		&parser.MemoryStats{BytesPerOp: 1024, AllocsPerOp: 12},
+	)
+
+	result := NewBasicComparator().Compare(baseline, current)
+
+	if len(result.Regressions) != 1 {
+		t.Fatalf("expected alloc increase to be a regression, got %v", result.Regressions)
+	}
+
+	comp := result.Benchmarks[0]
+	if !strings.Contains(comp.RegressionReason, "allocs/op 10 → 12 exceeds 0.0% threshold") {
+		t.Errorf("unexpected reason: %q", comp.RegressionReason)
+	}
+	if len(comp.Metrics) != 2 {
+		t.Fatalf("expected 2 metric comparisons, got %d", len(comp.Metrics))
+	}
+	for _, m := range comp.Metrics {
+		switch m.Name {
+		case MetricBytesPerOp:
+			if m.IsRegression || m.DeltaPercent != 0 {
+				t.Errorf("expected unchanged B/op, got %+v", m)
+			}
+		case MetricAllocsPerOp:
+			if !m.IsRegression || m.DeltaPercent != 20 {
+				t.Errorf("expected +20%% allocs/op regression, got %+v", m)
+			}
+		}
+	}
+}
+
+func TestCompare_MetricThresholds(t *testing.T) {
+	tests := []struct {
+		name       string
+		baseline   parser.MemoryStats
+		current    parser.MemoryStats
+		thresholds map[string]float64
+		want       bool
+	}{
+		{"bytes within default 5%", parser.MemoryStats{BytesPerOp: 1000}, parser.MemoryStats{BytesPerOp: 1040}, nil, false},
+		{"bytes beyond default 5%", parser.MemoryStats{BytesPerOp: 1000}, parser.MemoryStats{BytesPerOp: 1100}, nil, true},
+		{"bytes within custom 20%", parser.MemoryStats{BytesPerOp: 1000}, parser.MemoryStats{BytesPerOp: 1100}, map[string]float64{MetricBytesPerOp: 1.2}, false},
+		{"allocs from zero", parser.MemoryStats{}, parser.MemoryStats{AllocsPerOp: 1}, map[string]float64{MetricAllocsPerOp: 2.0}, true},
+		{"allocs decrease", parser.MemoryStats{AllocsPerOp: 5}, parser.MemoryStats{AllocsPerOp: 3}, nil, false},
+		{"allocs within custom 50%", parser.MemoryStats{AllocsPerOp: 4}, parser.MemoryStats{AllocsPerOp: 5}, map[string]float64{MetricAllocsPerOp: 1.5}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baselineMem, currentMem := tt.baseline, tt.current
+			baseline, current := memorySuites(&baselineMem, &currentMem)
+
+			comp := NewBasicComparator()
+			comp.MetricThresholds = tt.thresholds
+			result := comp.Compare(baseline, current)
+
+			if got := result.Benchmarks[0].IsRegression; got != tt.want {
+				t.Errorf("IsRegression = %v, want %v (reason %q)", got, tt.want, result.Benchmarks[0].RegressionReason)
+			}
+		})
+	}
+}
+
+func TestCompare_MetricFromZeroIsUnbounded(t *testing.T) {
+	baseline, current := memorySuites(&parser.MemoryStats{}, &parser.MemoryStats{AllocsPerOp: 1})
+
+	result := NewBasicComparator().Compare(baseline, current)
+
+	for _, m := range result.Benchmarks[0].Metrics {
+		if m.Name == MetricAllocsPerOp && !math.IsInf(m.DeltaPercent, 1) {
+			t.Errorf("expected +Inf delta from zero, got %v", m.DeltaPercent)
+		}
+	}
+}
+
+func TestCompare_NoMetricsWithoutMemory(t *testing.T) {
+	baseline, current := memorySuites(&parser.MemoryStats{AllocsPerOp: 1}, nil)
+
+	result := NewBasicComparator().Compare(baseline, current)
+
+	if result.Benchmarks[0].Metrics != nil {
+		t.Errorf("expected no metric comparisons when one side lacks memory data, got %v", result.Benchmarks[0].Metrics)
+	}
+	if result.Benchmarks[0].IsRegression {
+		t.Error("expected no regression")
+	}
+}
+
+func TestCompare_StatisticsIncludeMetricThresholds(t *testing.T) {
+	comp := NewBasicComparator()
+	comp.MetricThresholds = map[string]float64{MetricBytesPerOp: 1.25}
+
+	result := comp.Compare(&parser.BenchmarkSuite{}, &parser.BenchmarkSuite{})
+
+	thresholds := result.Statistics.MetricThresholds
+	if thresholds[MetricBytesPerOp] != 1.25 || thresholds[MetricAllocsPerOp] != 1.0 || thresholds[MetricInstructions] != 1.001 {
+		t.Errorf("unexpected metric thresholds: %v", thresholds)
+	}
+}
+
+func throughputSuites(baselineOps, currentOps float64) (*parser.BenchmarkSuite, *parser.BenchmarkSuite) {
+	// Node.js-style results where time is derived from ops/sec
+	result := func(ops float64) *parser.BenchmarkResult {
+		return &parser.BenchmarkResult{
+			Name:       "Array#forEach",
+			Language:   "nodejs",
+			Time:       time.Duration(1e9 / ops),
+			StdDev:     time.Duration(1e9 / ops / 200),
+			Iterations: 90,
+			Throughput: &parser.Throughput{Value: ops, Unit: "ops/s"},
+		}
+	}
+	return &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{result(baselineOps)}},
+		&parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{result(currentOps)}}
+}
+
+func TestCompare_ThroughputDrop(t *testing.T) {
+	baseline, current := throughputSuites(1_000_000, 920_000)
+
+	result := NewBasicComparator().Compare(baseline, current)
+	comp := result.Benchmarks[0]
+
+	if comp.Throughput == nil {
+		t.Fatal("expected throughput comparison")
+	}
+	if comp.Throughput.Direction != HigherIsBetter || comp.Throughput.Unit != "ops/s" {
+		t.Errorf("unexpected throughput comparison: %+v", comp.Throughput)
+	}
+	if math.Abs(comp.Throughput.DeltaPercent-(-8)) > 1e-9 {
+		t.Errorf("expected -8%% throughput delta, got %v", comp.Throughput.DeltaPercent)
+	}
+	if !comp.Slower() || comp.Faster() {
+		t.Error("expected a throughput drop to count as slower")
+	}
+	if !comp.ExceedsThreshold || !comp.IsRegression {
+		t.Errorf("expected an 8%% throughput drop to be a regression, reason %q", comp.RegressionReason)
+	}
+}
+
+func TestCompare_ThroughputGain(t *testing.T) {
+	baseline, current := throughputSuites(1_000_000, 1_100_000)
+
+	result := NewBasicComparator().Compare(baseline, current)
+	comp := result.Benchmarks[0]
+
+	if comp.IsRegression || comp.Throughput.IsRegression {
+		t.Error("expected a throughput gain not to be a regression")
+	}
+	if len(result.Improvements) != 1 {
+		t.Errorf("expected a throughput gain to be an improvement, got %v", result.Improvements)
+	}
+}
+
+func TestCompare_ThroughputUnitMismatch(t *testing.T) {
+	baseline, current := throughputSuites(1_000_000, 920_000)
+	current.Results[0].Throughput.Unit = "MB/s"
+
+	result := NewBasicComparator().Compare(baseline, current)
+
+	if result.Benchmarks[0].Throughput != nil {
+		t.Error("expected no throughput comparison across different units")
+	}
+}
+
+func TestCompareMetric_Direction(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction Direction
+		baseline  float64
+		current   float64
+		want      bool
+	}{
+		{"lower: increase", LowerIsBetter, 100, 110, true},
+		{"lower: decrease", LowerIsBetter, 100, 90, false},
+		{"higher: decrease", HigherIsBetter, 100, 90, true},
+		{"higher: increase", HigherIsBetter, 100, 110, false},
+		{"higher: small decrease", HigherIsBetter, 100, 97, false},
+		{"higher: drop to zero", HigherIsBetter, 100, 0, true},
+	}
+
+	for _, tt := range tests {
+		m := compareMetric("metric", "unit", tt.direction, 1.05, tt.baseline, tt.current)
+		if m.IsRegression != tt.want {
+			t.Errorf("%s: IsRegression = %v, want %v", tt.name, m.IsRegression, tt.want)
+		}
+	}
+}
+
+func TestCompare_CustomMetrics(t *testing.T) {
+	result := func(instructions, hitRate float64) *parser.BenchmarkResult {
+		return &parser.BenchmarkResult{
+			Name: "lookup", Language: "rust", Time: 1000 * time.Nanosecond, StdDev: 10, SampleCount: 20,
+			Metrics: []parser.Metric{
+				{Name: "instructions", Unit: "instr", Value: instructions},
+				{Name: "hit_rate", Unit: "%", Value: hitRate, HigherIsBetter: true},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		instructions float64
+		hitRate      float64
+		thresholds   map[string]float64
+		policy       RegressionPolicy
+		want         bool
+	}{
+		{"unchanged", 1000, 90, nil, PolicyBoth, false},
+		{"instructions within default threshold", 1000 * 1.0005, 90, nil, PolicyBoth, false},
+		{"instructions beyond default threshold", 1000 * 1.04, 90, nil, PolicyBoth, true},
+		{"instructions within custom threshold", 1000 * 1.04, 90, map[string]float64{"instructions": 1.05}, PolicyBoth, false},
+		{"instructions beyond custom threshold", 1000 * 1.02, 90, map[string]float64{"instructions": 1.01}, PolicyBoth, true},
+		// Noisy metrics without samples only fail under the threshold policy
+		{"hit rate drop", 1000, 80, nil, PolicyBoth, false},
+		{"hit rate drop under threshold policy", 1000, 80, nil, PolicyThreshold, true},
+		{"hit rate gain", 1000, 95, nil, PolicyThreshold, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := NewBasicComparator()
+			comp.MetricThresholds = tt.thresholds
+			comp.Policy = tt.policy
+
+			res := comp.Compare(
+				&parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{result(1000, 90)}},
+				&parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{result(tt.instructions, tt.hitRate)}},
+			)
+
+			bc := res.Benchmarks[0]
+			if len(bc.Metrics) != 2 {
+				t.Fatalf("expected 2 metric comparisons, got %d", len(bc.Metrics))
+			}
+			if bc.IsRegression != tt.want {
+				t.Errorf("IsRegression = %v, want %v (reason %q)", bc.IsRegression, tt.want, bc.RegressionReason)
+			}
+		})
+	}
+}
+
+func TestCompare_CustomMetricUnitMismatch(t *testing.T) {
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "lookup", Time: 1000, Metrics: []parser.Metric{{Name: "rss", Unit: "MB", Value: 10}}},
+	}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "lookup", Time: 1000, Metrics: []parser.Metric{{Name: "rss", Unit: "KB", Value: 10240}}},
+	}}
+
+	result := NewBasicComparator().Compare(baseline, current)
+
+	if len(result.Benchmarks[0].Metrics) != 0 {
+		t.Errorf("expected metrics in different units not to be compared, got %+v", result.Benchmarks[0].Metrics)
+	}
+}
+
+func TestCompare_GoReportedMetrics(t *testing.T) {
+	goParser := parser.NewGoParser()
+	baseline, err := goParser.Parse([]byte(`BenchmarkCache-8    1000000    1000 ns/op    100.00 MB/s    4.5 hits/op    0.98 p99-ms`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	current, err := goParser.Parse([]byte(`BenchmarkCache-8    1000000    1000 ns/op    100.00 MB/s    4.5 hits/op    1.20 p99-ms`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	bc := NewBasicComparator().Compare(baseline, current).Benchmarks[0]
+
+	if bc.Throughput == nil || bc.Throughput.Unit != "MB/s" {
+		t.Errorf("Throughput = %+v, want MB/s comparison", bc.Throughput)
+	}
+	if len(bc.Metrics) != 2 {
+		t.Fatalf("expected hits/op and p99-ms comparisons, got %d", len(bc.Metrics))
+	}
+	if bc.Metrics[0].Name != "hits/op" || bc.Metrics[0].IsRegression {
+		t.Errorf("Metrics[0] = %+v, want unchanged hits/op", bc.Metrics[0])
+	}
+	// A single p99 reading can't be told apart from noise under the default policy
+	if bc.Metrics[1].Name != "p99-ms" || !bc.Metrics[1].ExceedsThreshold || bc.Metrics[1].IsRegression {
+		t.Errorf("Metrics[1] = %+v, want p99-ms beyond threshold but not a regression", bc.Metrics[1])
+	}
+	if bc.IsRegression {
+		t.Errorf("IsRegression = true, want false (reason %q)", bc.RegressionReason)
+	}
+
+	comp := NewBasicComparator()
+	comp.Policy = PolicyThreshold
+	if bc := comp.Compare(baseline, current).Benchmarks[0]; !bc.IsRegression {
+		t.Errorf("IsRegression = false under the threshold policy, want true (reason %q)", bc.RegressionReason)
+	}
+}
+
+func TestCompare_NoisyMetricSamplesAreTested(t *testing.T) {
+	result := func(cpuTimes ...float64) *parser.BenchmarkResult {
+		return &parser.BenchmarkResult{
+			Name: "sort", Language: "cpp", Time: 1000,
+			Metrics: []parser.Metric{{Name: "cpu_time", Unit: "ns/op", Value: calculateMean(cpuTimes), Samples: cpuTimes}},
+		}
+	}
+	suites := func(baseline, current *parser.BenchmarkResult) (*parser.BenchmarkSuite, *parser.BenchmarkSuite) {
+		return &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{baseline}},
+			&parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{current}}
+	}
+
+	// 8% slower on average, but within the noise
+	bc := NewBasicComparator().Compare(suites(result(1000, 900, 1100, 1000), result(1080, 950, 1200, 1090))).Benchmarks[0]
+	m := bc.Metrics[0]
+	if m.Test != TestWelch || m.IsSignificant || !m.ExceedsThreshold || m.IsRegression {
+		t.Errorf("noisy cpu_time = %+v, want tested, beyond threshold, not significant, not a regression", m)
+	}
+
+	// 10% slower with tight timings
+	bc = NewBasicComparator().Compare(suites(result(1000, 1001, 999, 1000), result(1100, 1101, 1099, 1100))).Benchmarks[0]
+	m = bc.Metrics[0]
+	if !m.IsSignificant || !m.IsRegression || !bc.IsRegression {
+		t.Errorf("tight cpu_time = %+v, want a significant regression", m)
+	}
+	if !strings.Contains(bc.RegressionReason, "ns/op 1000 → 1100 exceeds 5.0% threshold, significant (p=") {
+		t.Errorf("RegressionReason = %q", bc.RegressionReason)
+	}
+}
+
+func TestCompare_IaiCallgrindInstructions(t *testing.T) {
+	iai := parser.NewIaiCallgrindParser()
+	baseline, err := iai.Parse([]byte("bench_fib short:10\n  Instructions:  10000\n  Estimated Cycles:  12000\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	current, err := iai.Parse([]byte("bench_fib short:10\n  Instructions:  10020\n  Estimated Cycles:  12000\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	bc := NewBasicComparator().Compare(baseline, current).Benchmarks[0]
+
+	if len(bc.Metrics) != 2 {
+		t.Fatalf("expected instructions and estimated_cycles comparisons, got %d", len(bc.Metrics))
+	}
+	if bc.Metrics[0].Name != MetricInstructions || bc.Metrics[0].Threshold != 1.001 || !bc.Metrics[0].IsRegression {
+		t.Errorf("Metrics[0] = %+v, want instructions regression at 1.001", bc.Metrics[0])
+	}
+	if bc.Metrics[1].IsRegression {
+		t.Errorf("Metrics[1] = %+v, want unchanged estimated_cycles", bc.Metrics[1])
+	}
+	if !bc.IsRegression {
+		t.Errorf("IsRegression = false, want true (reason %q)", bc.RegressionReason)
+	}
+}
+
+func TestCompare_DeterministicMetricsUseTightThreshold(t *testing.T) {
+	iai := parser.NewIaiCallgrindParser()
+	baseline, err := iai.Parse([]byte("bench_fib short:10\n  Instructions:  10000\n  Estimated Cycles:  12000\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	current, err := iai.Parse([]byte("bench_fib short:10\n  Instructions:  10000\n  Estimated Cycles:  12024\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	// 0.2% more estimated cycles: far below the time threshold, but not noise
+	bc := NewBasicComparator().Compare(baseline, current).Benchmarks[0]
+
+	cycles := bc.Metrics[1]
+	if cycles.Name != "estimated_cycles" || !cycles.Deterministic || cycles.Threshold != DeterministicMetricThreshold {
+		t.Fatalf("Metrics[1] = %+v, want deterministic estimated_cycles at %v", cycles, DeterministicMetricThreshold)
+	}
+	if !cycles.IsRegression || !bc.IsRegression {
+		t.Errorf("estimated_cycles regression = %v, benchmark regression = %v, want both", cycles.IsRegression, bc.IsRegression)
+	}
+}
@@ -0,0 +1,104 @@
+package comparator
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// RegressionPolicy decides which evidence is required to flag a regression
+type RegressionPolicy string
+
+const (
+	// PolicyThreshold flags a regression when the slowdown exceeds RegressionThreshold
+	PolicyThreshold RegressionPolicy = "threshold"
+
+	// PolicySignificance flags a regression when the slowdown is statistically significant
+	PolicySignificance RegressionPolicy = "significance"
+
+	// PolicyBoth requires the slowdown to exceed RegressionThreshold and be statistically significant
+	PolicyBoth RegressionPolicy = "both"
+)
+
+// ParseRegressionPolicy parses a regression policy name
+func ParseRegressionPolicy(name string) (RegressionPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "threshold":
+		return PolicyThreshold, nil
+	case "significance", "significant":
+		return PolicySignificance, nil
+	case "both", "":
+		return PolicyBoth, nil
+	default:
+		return "", fmt.Errorf("unknown regression policy: %s (must be threshold, significance, or both)", name)
+	}
+}
+
+// classify decides whether a comparison is a regression under the configured policy
+// and records the reason. Significance must already reflect any p-value correction.
+// When the policy needs a significance test but there is no variance data to run one,
+// the threshold alone decides, so such results can still fail CI.
+func (bc *BasicComparator) classify(c *BenchmarkComparison) {
+	policy := bc.Policy
+	if policy != PolicyThreshold && c.Test == "" {
+		policy = PolicyThreshold
+		c.ThresholdFallback = true
+	}
+
+	var blockers []string
+
+	if !c.Slower() {
+		blockers = append(blockers, "not slower")
+	} else {
+		if policy != PolicySignificance && !c.ExceedsThreshold {
+			blockers = append(blockers, fmt.Sprintf("within %s threshold", thresholdLabel(bc.RegressionThreshold)))
+		}
+		if policy != PolicyThreshold && !c.IsSignificant {
+			blockers = append(blockers, fmt.Sprintf("not significant (p=%.4f)", c.AdjustedPValue))
+		}
+		// The effect size needs variance data too
+		if bc.MinEffectSize > 0 && !c.ThresholdFallback && math.Abs(c.EffectSize) < bc.MinEffectSize {
+			blockers = append(blockers, fmt.Sprintf("effect size %.2f below %.2f", math.Abs(c.EffectSize), bc.MinEffectSize))
+		}
+	}
+
+	// Metric regressions, already judged under the policy, fail regardless of the time verdict
+	metricReasons := metricRegressionReasons(c.Metrics)
+
+	c.IsRegression = len(blockers) == 0 || len(metricReasons) > 0
+	if len(blockers) > 0 {
+		if len(metricReasons) > 0 {
+			c.RegressionReason = strings.Join(metricReasons, "; ")
+		} else {
+			c.RegressionReason = strings.Join(blockers, "; ")
+			if c.ThresholdFallback && c.Slower() {
+				c.RegressionReason += " " + thresholdFallbackNote
+			}
+		}
+		return
+	}
+
+	var evidence []string
+	if policy != PolicySignificance {
+		evidence = append(evidence, fmt.Sprintf("exceeds %s threshold", thresholdLabel(bc.RegressionThreshold)))
+	}
+	if policy != PolicyThreshold {
+		evidence = append(evidence, fmt.Sprintf("significant (p=%.4f)", c.AdjustedPValue))
+	}
+	if bc.MinEffectSize > 0 && !c.ThresholdFallback {
+		evidence = append(evidence, fmt.Sprintf("effect size %.2f", math.Abs(c.EffectSize)))
+	}
+	reason := strings.Join(evidence, ", ")
+	if c.ThresholdFallback {
+		reason += " " + thresholdFallbackNote
+	}
+	c.RegressionReason = strings.Join(append([]string{reason}, metricReasons...), "; ")
+}
+
+// thresholdFallbackNote explains a verdict reached by the threshold alone
+const thresholdFallbackNote = "(no variance data for significance test, threshold used)"
+
+// thresholdLabel formats a regression threshold multiplier as a percentage (1.05 -> "5.0%")
+func thresholdLabel(threshold float64) string {
+	return fmt.Sprintf("%.1f%%", (threshold-1)*100)
+}
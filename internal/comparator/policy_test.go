@@ -0,0 +1,173 @@
+package comparator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestParseRegressionPolicy(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    RegressionPolicy
+		wantErr bool
+	}{
+		{"threshold", PolicyThreshold, false},
+		{"Significance", PolicySignificance, false},
+		{"both", PolicyBoth, false},
+		{"", PolicyBoth, false},
+		{"either", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseRegressionPolicy(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseRegressionPolicy(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseRegressionPolicy(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func policySuites(currentTime, stdDev time.Duration) (*parser.BenchmarkSuite, *parser.BenchmarkSuite) {
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1000 * time.Nanosecond, StdDev: stdDev, SampleCount: 20},
+	}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: currentTime, StdDev: stdDev, SampleCount: 20},
+	}}
+	return baseline, current
+}
+
+func TestCompare_RegressionPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		currentTime time.Duration
+		stdDev      time.Duration
+		policy      RegressionPolicy
+		want        bool
+		reason      string
+	}{
+		// 10% slower but very noisy: exceeds threshold, not significant
+		{"noisy threshold", 1100, 500, PolicyThreshold, true, "exceeds 5.0% threshold"},
+		{"noisy significance", 1100, 500, PolicySignificance, false, "not significant"},
+		{"noisy both", 1100, 500, PolicyBoth, false, "not significant"},
+
+		// 2% slower with tight timings: significant, within threshold
+		{"tight threshold", 1020, 5, PolicyThreshold, false, "within 5.0% threshold"},
+		{"tight significance", 1020, 5, PolicySignificance, true, "significant (p="},
+		{"tight both", 1020, 5, PolicyBoth, false, "within 5.0% threshold"},
+
+		// 10% slower with tight timings: flagged by every policy
+		{"clear both", 1100, 5, PolicyBoth, true, "exceeds 5.0% threshold, significant"},
+
+		// Faster is never a regression
+		{"faster", 900, 5, PolicySignificance, false, "not slower"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := NewBasicComparator()
+			comp.Policy = tt.policy
+
+			baseline, current := policySuites(tt.currentTime, tt.stdDev)
+			result := comp.Compare(baseline, current)
+			bc := result.Benchmarks[0]
+
+			if bc.IsRegression != tt.want {
+				t.Errorf("IsRegression = %v, want %v (reason %q)", bc.IsRegression, tt.want, bc.RegressionReason)
+			}
+			if !strings.Contains(bc.RegressionReason, tt.reason) {
+				t.Errorf("RegressionReason = %q, want it to contain %q", bc.RegressionReason, tt.reason)
+			}
+			if got := len(result.Regressions) == 1; got != tt.want {
+				t.Errorf("Regressions = %v", result.Regressions)
+			}
+			if result.Statistics.Policy != tt.policy {
+				t.Errorf("Statistics.Policy = %q, want %q", result.Statistics.Policy, tt.policy)
+			}
+		})
+	}
+}
+
+func TestCompare_MinEffectSize(t *testing.T) {
+	// 6% slower, significant with many iterations, but a small effect (d = 0.3)
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1000, StdDev: 200, SampleCount: 10000},
+	}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1060, StdDev: 200, SampleCount: 10000},
+	}}
+
+	comp := NewBasicComparator()
+	if !comp.Compare(baseline, current).Benchmarks[0].IsRegression {
+		t.Fatal("expected a regression without an effect size gate")
+	}
+
+	comp.MinEffectSize = 0.5
+	bc := comp.Compare(baseline, current).Benchmarks[0]
+	if bc.IsRegression {
+		t.Error("IsRegression = true, want false below minimum effect size")
+	}
+	if !strings.Contains(bc.RegressionReason, "effect size 0.30 below 0.50") {
+		t.Errorf("RegressionReason = %q", bc.RegressionReason)
+	}
+}
+
+func TestCompare_NoVarianceDataFallsBackToThreshold(t *testing.T) {
+	tests := []struct {
+		name        string
+		currentTime time.Duration
+		policy      RegressionPolicy
+		want        bool
+		reason      string
+	}{
+		{"both slower", 2000, PolicyBoth, true, "exceeds 5.0% threshold (no variance data"},
+		{"significance slower", 2000, PolicySignificance, true, "exceeds 5.0% threshold (no variance data"},
+		{"both within threshold", 1020, PolicyBoth, false, "within 5.0% threshold (no variance data"},
+		{"both faster", 900, PolicyBoth, false, "not slower"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := NewBasicComparator()
+			comp.Policy = tt.policy
+			comp.MinEffectSize = 0.5
+
+			baseline, current := policySuites(tt.currentTime, 0)
+			result := comp.Compare(baseline, current)
+			bc := result.Benchmarks[0]
+
+			if bc.IsRegression != tt.want {
+				t.Errorf("IsRegression = %v, want %v (reason %q)", bc.IsRegression, tt.want, bc.RegressionReason)
+			}
+			if !bc.ThresholdFallback {
+				t.Error("ThresholdFallback = false, want true")
+			}
+			if !strings.Contains(bc.RegressionReason, tt.reason) {
+				t.Errorf("RegressionReason = %q, want it to contain %q", bc.RegressionReason, tt.reason)
+			}
+			if result.Summary.ThresholdFallbacks != 1 {
+				t.Errorf("Summary.ThresholdFallbacks = %d, want 1", result.Summary.ThresholdFallbacks)
+			}
+		})
+	}
+}
+
+func TestCompare_ThresholdPolicyNeverFallsBack(t *testing.T) {
+	comp := NewBasicComparator()
+	comp.Policy = PolicyThreshold
+
+	baseline, current := policySuites(2000, 0)
+	bc := comp.Compare(baseline, current).Benchmarks[0]
+	if bc.ThresholdFallback {
+		t.Error("ThresholdFallback = true, want false under the threshold policy")
+	}
+	if !bc.IsRegression {
+		t.Errorf("IsRegression = false, want true (reason %q)", bc.RegressionReason)
+	}
+}
@@ -0,0 +1,75 @@
+package comparator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Rename records a baseline benchmark that was matched to a current benchmark under a new name
+type Rename struct {
+	// Old is the benchmark name in the baseline suite
+	Old string
+
+	// New is the benchmark name in the current suite
+	New string
+}
+
+// LanguageMismatch records a benchmark whose language differs between baseline and current
+type LanguageMismatch struct {
+	// Name is the benchmark name
+	Name string
+
+	// BaselineLanguage is the language in the baseline suite
+	BaselineLanguage string
+
+	// CurrentLanguage is the language in the current suite
+	CurrentLanguage string
+}
+
+// ParseRenames parses a rename mapping with one "old_name -> new_name" pair per line.
+// Blank lines and lines starting with '#' are ignored.
+func ParseRenames(r io.Reader) (map[string]string, error) {
+	renames := make(map[string]string)
+	targets := make(map[string]string)
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.Split(line, "->")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected 'old_name -> new_name', got %q", lineNum, line)
+		}
+
+		oldName := strings.TrimSpace(parts[0])
+		newName := strings.TrimSpace(parts[1])
+		if oldName == "" || newName == "" {
+			return nil, fmt.Errorf("line %d: benchmark names must not be empty", lineNum)
+		}
+		if oldName == newName {
+			return nil, fmt.Errorf("line %d: %s is renamed to itself", lineNum, oldName)
+		}
+		if existing, ok := renames[oldName]; ok {
+			return nil, fmt.Errorf("line %d: %s is already renamed to %s", lineNum, oldName, existing)
+		}
+		if existing, ok := targets[newName]; ok {
+			return nil, fmt.Errorf("line %d: %s is already the new name of %s", lineNum, newName, existing)
+		}
+
+		renames[oldName] = newName
+		targets[newName] = oldName
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rename mapping: %w", err)
+	}
+
+	return renames, nil
+}
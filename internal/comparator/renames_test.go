@@ -0,0 +1,105 @@
+package comparator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestParseRenames(t *testing.T) {
+	input := `# renamed during the sort refactor
+BenchmarkSort -> BenchmarkSortInts
+  BenchmarkFind->BenchmarkSearch  
+
+`
+	renames, err := ParseRenames(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseRenames() error = %v", err)
+	}
+
+	want := map[string]string{
+		"BenchmarkSort": "BenchmarkSortInts",
+		"BenchmarkFind": "BenchmarkSearch",
+	}
+	if len(renames) != len(want) {
+		t.Fatalf("len(renames) = %d, want %d", len(renames), len(want))
+	}
+	for oldName, newName := range want {
+		if renames[oldName] != newName {
+			t.Errorf("renames[%q] = %q, want %q", oldName, renames[oldName], newName)
+		}
+	}
+}
+
+func TestParseRenames_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"missing arrow", "BenchmarkSort BenchmarkSortInts"},
+		{"empty name", "BenchmarkSort -> "},
+		{"self rename", "a -> a"},
+		{"duplicate old name", "a -> b\na -> c"},
+		{"duplicate new name", "a -> c\nb -> c"},
+		{"multiple arrows", "a -> b -> c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseRenames(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseRenames() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestCompare_AddedRemovedRenamed(t *testing.T) {
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Language: "go", Time: 1000 * time.Nanosecond},
+		{Name: "find", Language: "go", Time: 500 * time.Nanosecond},
+		{Name: "legacy", Language: "go", Time: 200 * time.Nanosecond},
+		{Name: "hash", Language: "rust", Time: 300 * time.Nanosecond},
+	}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Language: "go", Time: 1000 * time.Nanosecond},
+		{Name: "search", Language: "go", Time: 510 * time.Nanosecond},
+		{Name: "parse", Language: "go", Time: 100 * time.Nanosecond},
+		{Name: "hash", Language: "go", Time: 300 * time.Nanosecond},
+	}}
+
+	comp := NewBasicComparator()
+	comp.Renames = map[string]string{"find": "search"}
+
+	result := comp.Compare(baseline, current)
+
+	if result.Summary.TotalComparisons != 2 {
+		t.Errorf("TotalComparisons = %d, want 2", result.Summary.TotalComparisons)
+	}
+	if len(result.Added) != 1 || result.Added[0] != "parse" {
+		t.Errorf("Added = %v, want [parse]", result.Added)
+	}
+	if len(result.Removed) != 1 || result.Removed[0] != "legacy" {
+		t.Errorf("Removed = %v, want [legacy]", result.Removed)
+	}
+	if len(result.Renamed) != 1 || result.Renamed[0] != (Rename{Old: "find", New: "search"}) {
+		t.Errorf("Renamed = %v, want [find -> search]", result.Renamed)
+	}
+
+	want := LanguageMismatch{Name: "hash", BaselineLanguage: "rust", CurrentLanguage: "go"}
+	if len(result.LanguageMismatches) != 1 || result.LanguageMismatches[0] != want {
+		t.Errorf("LanguageMismatches = %v, want [%v]", result.LanguageMismatches, want)
+	}
+}
+
+func TestCompare_EmptyCurrentReportsRemoved(t *testing.T) {
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Language: "go", Time: 1000 * time.Nanosecond},
+	}}
+
+	result := NewBasicComparator().Compare(baseline, &parser.BenchmarkSuite{})
+	if len(result.Removed) != 1 || result.Removed[0] != "sort" {
+		t.Errorf("Removed = %v, want [sort]", result.Removed)
+	}
+}
@@ -0,0 +1,349 @@
+package comparator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+// StatisticalTest identifies the hypothesis test used to compare two benchmarks
+type StatisticalTest string
+
+const (
+	// TestWelch is Welch's unequal-variances t-test
+	TestWelch StatisticalTest = "welch"
+
+	// TestMannWhitney is the non-parametric Mann–Whitney U test (requires raw samples)
+	TestMannWhitney StatisticalTest = "mannwhitney"
+)
+
+// ParseStatisticalTest parses a statistical test name
+func ParseStatisticalTest(name string) (StatisticalTest, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "welch", "t", "ttest", "t-test":
+		return TestWelch, nil
+	case "mannwhitney", "mann-whitney", "mwu", "u":
+		return TestMannWhitney, nil
+	default:
+		return "", fmt.Errorf("unknown statistical test: %s (must be welch or mannwhitney)", name)
+	}
+}
+
+// TestOutcome holds the result of a significance test between two benchmarks
+type TestOutcome struct {
+	// Test is the test that was run, or empty when there was not enough data
+	Test StatisticalTest
+
+	// Statistic is the t statistic (Welch) or U statistic (Mann–Whitney)
+	Statistic float64
+
+	// DegreesOfFreedom is the Welch–Satterthwaite degrees of freedom (Welch only)
+	DegreesOfFreedom float64
+
+	// PValue is the two-sided p-value
+	PValue float64
+}
+
+// observations describes the measurements available for one benchmark result
+type observations struct {
+	samples []float64 // Raw samples in nanoseconds (nil when unavailable)
+	mean    float64
+	stdDev  float64
+	n       int
+}
+
+// observe extracts the measurements available for a result.
+// Raw samples are preferred; otherwise the reported mean and standard deviation
+// are used with the reported sample count. Iterations are loop counts, not
+// independent samples, so results without either cannot be tested.
+func observe(r *parser.BenchmarkResult) observations {
+	if len(r.Samples) >= 2 {
+		samples := make([]float64, len(r.Samples))
+		for i, s := range r.Samples {
+			samples[i] = float64(s)
+		}
+		mean := calculateMean(samples)
+		return observations{
+			samples: samples,
+			mean:    mean,
+			stdDev:  calculateStdDev(samples, mean),
+			n:       len(samples),
+		}
+	}
+
+	obs := observations{
+		mean:   float64(r.Time),
+		stdDev: float64(r.StdDev),
+	}
+	if r.SampleCount >= 2 && r.StdDev > 0 {
+		obs.n = int(r.SampleCount)
+	}
+	return obs
+}
+
+// runTest runs the configured significance test between two results.
+// Mann–Whitney requires raw samples on both sides and falls back to Welch otherwise.
+// When neither side has enough data for a test, the outcome has an empty Test and p-value 1.
+func runTest(test StatisticalTest, baseline, current *parser.BenchmarkResult) TestOutcome {
+	base := observe(baseline)
+	curr := observe(current)
+
+	if test == TestMannWhitney && base.samples != nil && curr.samples != nil {
+		u, p := MannWhitneyUTest(base.samples, curr.samples)
+		return TestOutcome{Test: TestMannWhitney, Statistic: u, PValue: p}
+	}
+
+	if base.n < 2 || curr.n < 2 {
+		return TestOutcome{PValue: 1.0}
+	}
+
+	t, df, p := WelchTTestSummary(base.mean, base.stdDev, base.n, curr.mean, curr.stdDev, curr.n)
+	return TestOutcome{Test: TestWelch, Statistic: t, DegreesOfFreedom: df, PValue: p}
+}
+
+// effectSize calculates Cohen's d between two results from samples when available,
+// otherwise from the reported means and standard deviations
+func effectSize(baseline, current *parser.BenchmarkResult) float64 {
+	base := observe(baseline)
+	curr := observe(current)
+
+	if base.samples != nil && curr.samples != nil {
+		return CohensDEffect(base.samples, curr.samples)
+	}
+
+	return CohensDFromSummary(base.mean, base.stdDev, base.n, curr.mean, curr.stdDev, curr.n)
+}
+
+// WelchTTest performs Welch's unequal-variances t-test on two samples.
+// Returns the t statistic (positive when b is larger), the Welch–Satterthwaite
+// degrees of freedom, and the two-sided p-value.
+func WelchTTest(a, b []float64) (t, df, pValue float64) {
+	if len(a) < 2 || len(b) < 2 {
+		return 0, 0, 1.0
+	}
+
+	meanA := calculateMean(a)
+	meanB := calculateMean(b)
+
+	return WelchTTestSummary(
+		meanA, calculateStdDev(a, meanA), len(a),
+		meanB, calculateStdDev(b, meanB), len(b),
+	)
+}
+
+// WelchTTestSummary performs Welch's t-test from summary statistics
+func WelchTTestSummary(mean1, sd1 float64, n1 int, mean2, sd2 float64, n2 int) (t, df, pValue float64) {
+	if n1 < 2 || n2 < 2 {
+		return 0, 0, 1.0
+	}
+
+	v1 := sd1 * sd1 / float64(n1)
+	v2 := sd2 * sd2 / float64(n2)
+	se2 := v1 + v2
+
+	// Zero variance on both sides: any difference is exact
+	if se2 == 0 {
+		if mean1 == mean2 {
+			return 0, float64(n1 + n2 - 2), 1.0
+		}
+		return math.Copysign(math.Inf(1), mean2-mean1), float64(n1 + n2 - 2), 0
+	}
+
+	t = (mean2 - mean1) / math.Sqrt(se2)
+	df = se2 * se2 / (v1*v1/float64(n1-1) + v2*v2/float64(n2-1))
+	pValue = studentTTwoTailed(t, df)
+
+	return t, df, pValue
+}
+
+// MannWhitneyUTest performs the two-sided Mann–Whitney U test on two samples.
+// Returns the U statistic for b and the p-value from the normal approximation
+// with tie and continuity corrections.
+func MannWhitneyUTest(a, b []float64) (u, pValue float64) {
+	n1 := len(a)
+	n2 := len(b)
+	if n1 == 0 || n2 == 0 {
+		return 0, 1.0
+	}
+
+	type observation struct {
+		value   float64
+		fromB   bool
+		ranking float64
+	}
+
+	combined := make([]observation, 0, n1+n2)
+	for _, v := range a {
+		combined = append(combined, observation{value: v})
+	}
+	for _, v := range b {
+		combined = append(combined, observation{value: v, fromB: true})
+	}
+
+	sort.Slice(combined, func(i, j int) bool {
+		return combined[i].value < combined[j].value
+	})
+
+	// Assign average ranks to ties and accumulate the tie correction term
+	tieTerm := 0.0
+	for i := 0; i < len(combined); {
+		j := i
+		for j < len(combined) && combined[j].value == combined[i].value {
+			j++
+		}
+		avgRank := float64(i+j+1) / 2 // ranks are 1-based: (i+1 + j) / 2
+		for k := i; k < j; k++ {
+			combined[k].ranking = avgRank
+		}
+		ties := float64(j - i)
+		tieTerm += ties*ties*ties - ties
+		i = j
+	}
+
+	rankSumB := 0.0
+	for _, o := range combined {
+		if o.fromB {
+			rankSumB += o.ranking
+		}
+	}
+
+	fn1 := float64(n1)
+	fn2 := float64(n2)
+	n := fn1 + fn2
+
+	u = rankSumB - fn2*(fn2+1)/2
+	mu := fn1 * fn2 / 2
+	sigma := math.Sqrt(fn1 * fn2 / 12 * ((n + 1) - tieTerm/(n*(n-1))))
+
+	if sigma == 0 {
+		return u, 1.0
+	}
+
+	// Continuity correction towards the mean
+	diff := math.Abs(u-mu) - 0.5
+	if diff <= 0 {
+		return u, 1.0
+	}
+	z := diff / sigma
+
+	pValue = 2 * (1 - normalCDF(z))
+	if pValue > 1 {
+		pValue = 1
+	}
+
+	return u, pValue
+}
+
+// CohensDFromSummary calculates Cohen's d from means, standard deviations and sample sizes.
+// When sample sizes are unknown, the standard deviations are weighted equally.
+func CohensDFromSummary(mean1, sd1 float64, n1 int, mean2, sd2 float64, n2 int) float64 {
+	var pooledVariance float64
+	if n1 >= 2 && n2 >= 2 {
+		pooledVariance = (float64(n1-1)*sd1*sd1 + float64(n2-1)*sd2*sd2) / float64(n1+n2-2)
+	} else {
+		pooledVariance = (sd1*sd1 + sd2*sd2) / 2
+	}
+
+	if pooledVariance == 0 {
+		return 0
+	}
+
+	return (mean2 - mean1) / math.Sqrt(pooledVariance)
+}
+
+// studentTTwoTailed returns the two-sided p-value of a t statistic with df degrees of freedom
+func studentTTwoTailed(t, df float64) float64 {
+	if math.IsNaN(t) || df <= 0 {
+		return 1.0
+	}
+	if math.IsInf(t, 0) {
+		return 0
+	}
+
+	x := df / (df + t*t)
+	return regularizedIncompleteBeta(df/2, 0.5, x)
+}
+
+// regularizedIncompleteBeta evaluates I_x(a, b) using a continued fraction expansion
+func regularizedIncompleteBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lgA, _ := math.Lgamma(a)
+	lgB, _ := math.Lgamma(b)
+	lgAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lgAB - lgA - lgB + a*math.Log(x) + b*math.Log(1-x))
+
+	// The continued fraction converges quickly for x < (a+1)/(a+b+2)
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(a, b, x) / a
+	}
+	return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction for the incomplete beta function
+// using the modified Lentz method
+func betaContinuedFraction(a, b, x float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+
+		// Even step
+		aa := fm * (b - fm) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		// Odd step
+		aa = -(a + fm) * (qab + fm) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+
+	return h
+}
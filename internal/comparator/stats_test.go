@@ -0,0 +1,233 @@
+package comparator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jpequegn/benchflow/internal/parser"
+)
+
+func TestStudentTTwoTailed(t *testing.T) {
+	tests := []struct {
+		name string
+		t    float64
+		df   float64
+		want float64
+	}{
+		{"zero statistic", 0, 10, 1.0},
+		{"critical value df=10", 2.228, 10, 0.05},
+		{"critical value df=30", 2.042, 30, 0.05},
+		{"negative statistic", -2.228, 10, 0.05},
+		{"critical value df=5 at 1%", 4.032, 5, 0.01},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := studentTTwoTailed(tt.t, tt.df)
+			if math.Abs(got-tt.want) > 0.001 {
+				t.Errorf("studentTTwoTailed(%v, %v) = %v, want %v", tt.t, tt.df, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	a := []float64{1, 2, 3, 4, 5}
+	b := []float64{2, 4, 6, 8, 10}
+
+	tStat, df, p := WelchTTest(a, b)
+
+	// means 3 and 6, variances 2.5 and 10
+	if math.Abs(tStat-1.8974) > 0.001 {
+		t.Errorf("t = %v, want 1.8974", tStat)
+	}
+	if math.Abs(df-5.8824) > 0.001 {
+		t.Errorf("df = %v, want 5.8824", df)
+	}
+	if p < 0.10 || p > 0.12 {
+		t.Errorf("p = %v, want ~0.107", p)
+	}
+}
+
+func TestWelchTTest_InsufficientData(t *testing.T) {
+	_, _, p := WelchTTest([]float64{1}, []float64{1, 2, 3})
+	if p != 1.0 {
+		t.Errorf("p = %v, want 1.0 for a single observation", p)
+	}
+}
+
+func TestWelchTTestSummary_ZeroVariance(t *testing.T) {
+	_, _, p := WelchTTestSummary(100, 0, 10, 100, 0, 10)
+	if p != 1.0 {
+		t.Errorf("identical constant samples: p = %v, want 1.0", p)
+	}
+
+	tStat, _, p := WelchTTestSummary(100, 0, 10, 110, 0, 10)
+	if p != 0 || !math.IsInf(tStat, 1) {
+		t.Errorf("different constant samples: t = %v, p = %v, want +Inf, 0", tStat, p)
+	}
+}
+
+func TestMannWhitneyUTest(t *testing.T) {
+	a := []float64{1, 2, 3, 4, 5}
+	b := []float64{6, 7, 8, 9, 10}
+
+	u, p := MannWhitneyUTest(a, b)
+	if u != 25 {
+		t.Errorf("U = %v, want 25", u)
+	}
+	// Normal approximation with continuity correction: z = 12 / sqrt(275/12)
+	if math.Abs(p-0.0122) > 0.001 {
+		t.Errorf("p = %v, want ~0.0122", p)
+	}
+
+	u, p = MannWhitneyUTest(a, a)
+	if u != 12.5 {
+		t.Errorf("identical samples U = %v, want 12.5", u)
+	}
+	if p != 1.0 {
+		t.Errorf("identical samples p = %v, want 1.0", p)
+	}
+}
+
+func TestMannWhitneyUTest_AllTied(t *testing.T) {
+	_, p := MannWhitneyUTest([]float64{5, 5, 5}, []float64{5, 5})
+	if p != 1.0 {
+		t.Errorf("p = %v, want 1.0 when all values are tied", p)
+	}
+}
+
+func TestParseStatisticalTest(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    StatisticalTest
+		wantErr bool
+	}{
+		{"welch", TestWelch, false},
+		{"T-Test", TestWelch, false},
+		{"mannwhitney", TestMannWhitney, false},
+		{"Mann-Whitney", TestMannWhitney, false},
+		{"anova", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseStatisticalTest(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseStatisticalTest(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseStatisticalTest(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestCohensDFromSummary(t *testing.T) {
+	d := CohensDFromSummary(100, 10, 20, 110, 10, 20)
+	if math.Abs(d-1.0) > 1e-9 {
+		t.Errorf("d = %v, want 1.0", d)
+	}
+
+	if d := CohensDFromSummary(100, 0, 0, 110, 0, 0); d != 0 {
+		t.Errorf("zero variance d = %v, want 0", d)
+	}
+}
+
+func durations(ns ...int) []time.Duration {
+	out := make([]time.Duration, len(ns))
+	for i, v := range ns {
+		out[i] = time.Duration(v)
+	}
+	return out
+}
+
+func TestCompare_WithSamples(t *testing.T) {
+	baseline := &parser.BenchmarkSuite{
+		Language: "go",
+		Results: []*parser.BenchmarkResult{
+			{Name: "sort", Language: "go", Time: 1000, Samples: durations(990, 1000, 1010, 995, 1005, 1000)},
+		},
+	}
+	current := &parser.BenchmarkSuite{
+		Language: "go",
+		Results: []*parser.BenchmarkResult{
+			{Name: "sort", Language: "go", Time: 1200, Samples: durations(1190, 1200, 1210, 1195, 1205, 1200)},
+		},
+	}
+
+	for _, test := range []StatisticalTest{TestWelch, TestMannWhitney} {
+		comp := NewBasicComparator()
+		comp.Test = test
+
+		result := comp.Compare(baseline, current)
+		bc := result.Benchmarks[0]
+
+		if bc.Test != test {
+			t.Errorf("%s: Test = %q, want %q", test, bc.Test, test)
+		}
+		if !bc.IsSignificant {
+			t.Errorf("%s: IsSignificant = false, want true (p = %v)", test, bc.PValue)
+		}
+		if !bc.IsRegression {
+			t.Errorf("%s: IsRegression = false, want true", test)
+		}
+		if result.Statistics.Test != test {
+			t.Errorf("%s: Statistics.Test = %q", test, result.Statistics.Test)
+		}
+	}
+}
+
+func TestCompare_MannWhitneyFallsBackWithoutSamples(t *testing.T) {
+	comp := NewBasicComparator()
+	comp.Test = TestMannWhitney
+
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1000, StdDev: 10, SampleCount: 50},
+	}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1100, StdDev: 10, SampleCount: 50},
+	}}
+
+	bc := comp.Compare(baseline, current).Benchmarks[0]
+	if bc.Test != TestWelch {
+		t.Errorf("Test = %q, want fallback to %q", bc.Test, TestWelch)
+	}
+	if bc.DegreesOfFreedom <= 0 {
+		t.Errorf("DegreesOfFreedom = %v, want > 0", bc.DegreesOfFreedom)
+	}
+}
+
+func TestCompare_NoVarianceInformation(t *testing.T) {
+	comp := NewBasicComparator()
+
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{{Name: "sort", Time: 1000}}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{{Name: "sort", Time: 2000}}}
+
+	bc := comp.Compare(baseline, current).Benchmarks[0]
+	if bc.Test != "" || bc.IsSignificant || bc.PValue != 1.0 {
+		t.Errorf("expected no test without variance data, got test=%q significant=%v p=%v", bc.Test, bc.IsSignificant, bc.PValue)
+	}
+}
+
+func TestCompare_IterationsAreNotSampleSize(t *testing.T) {
+	// A 1% difference over a million loop iterations is not a million samples
+	baseline := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1000, StdDev: 10, Iterations: 1000000},
+	}}
+	current := &parser.BenchmarkSuite{Results: []*parser.BenchmarkResult{
+		{Name: "sort", Time: 1010, StdDev: 10, Iterations: 1000000},
+	}}
+
+	bc := NewBasicComparator().Compare(baseline, current).Benchmarks[0]
+	if bc.Test != "" || bc.IsSignificant {
+		t.Errorf("Test = %q, IsSignificant = %v, want no test without a sample count", bc.Test, bc.IsSignificant)
+	}
+
+	baseline.Results[0].SampleCount = 5
+	current.Results[0].SampleCount = 5
+	bc = NewBasicComparator().Compare(baseline, current).Benchmarks[0]
+	if bc.Test != TestWelch {
+		t.Errorf("Test = %q, want %q with a sample count", bc.Test, TestWelch)
+	}
+}
@@ -0,0 +1,309 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AsvParser implements Parser for airspeed velocity (asv) result files
+// (.asv/results/<machine>/<commit>-<env>.json)
+type AsvParser struct{}
+
+// NewAsvParser creates a new asv parser
+func NewAsvParser() *AsvParser {
+	return &AsvParser{}
+}
+
+// Language returns the language this parser supports
+func (p *AsvParser) Language() string {
+	return "python"
+}
+
+// asvResultsJSON represents an asv result file (format version 2)
+type asvResultsJSON struct {
+	CommitHash    string                       `json:"commit_hash"`
+	EnvName       string                       `json:"env_name"`
+	Date          int64                        `json:"date"` // Milliseconds since the epoch
+	Params        map[string]interface{}       `json:"params"`
+	Python        string                       `json:"python"`
+	Requirements  map[string]interface{}       `json:"requirements"`
+	ResultColumns []string                     `json:"result_columns"`
+	Results       map[string][]json.RawMessage `json:"results"`
+	Version       int                          `json:"version"`
+}
+
+// asvBenchmark holds the columns of one benchmark's results. Every column except
+// params and version has one entry per parameter combination.
+type asvBenchmark struct {
+	Result  []*float64  `json:"result"`
+	Params  [][]string  `json:"params"`
+	Version string      `json:"version"`
+	CI99A   []*float64  `json:"stats_ci_99_a"`
+	CI99B   []*float64  `json:"stats_ci_99_b"`
+	Q25     []*float64  `json:"stats_q_25"`
+	Q75     []*float64  `json:"stats_q_75"`
+	Number  []*float64  `json:"stats_number"`
+	Repeat  []*float64  `json:"stats_repeat"`
+	Samples [][]float64 `json:"samples"`
+}
+
+// Parse parses an asv result file.
+// Parameterized benchmarks are expanded into one result per parameter combination,
+// named "benchmark(param1, param2)". Failed and skipped combinations are left out.
+func (p *AsvParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data asvResultsJSON
+	if err := json.Unmarshal(replaceJSONNonFinite(output), &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+	if data.Version != 2 {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("unsupported asv result format version %d (expected 2)", data.Version),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "python",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+	data.addMetadata(suite.Metadata)
+
+	names := make([]string, 0, len(data.Results))
+	for name := range data.Results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		bench, err := decodeAsvBenchmark(data.Results[name], data.ResultColumns)
+		if err != nil {
+			return nil, &ParseError{
+				Line:    i + 1,
+				Message: fmt.Sprintf("invalid result for %s: %v", name, err),
+				Input:   name,
+			}
+		}
+		suite.Results = append(suite.Results, bench.results(name)...)
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// decodeAsvBenchmark decodes the columns of a result entry, named by result_columns
+func decodeAsvBenchmark(values []json.RawMessage, columns []string) (*asvBenchmark, error) {
+	object := make(map[string]json.RawMessage, len(values))
+	for i, value := range values {
+		if i < len(columns) {
+			object[columns[i]] = value
+		}
+	}
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var bench asvBenchmark
+	if err := json.Unmarshal(data, &bench); err != nil {
+		return nil, err
+	}
+	return &bench, nil
+}
+
+// results expands a benchmark into one result per parameter combination that has a value
+func (b *asvBenchmark) results(name string) []*BenchmarkResult {
+	var results []*BenchmarkResult
+	for i, combination := range asvCombinations(b.Params) {
+		if i >= len(b.Result) || b.Result[i] == nil {
+			// Failed (null) or skipped (NaN)
+			continue
+		}
+
+		result := &BenchmarkResult{
+			Name:     name,
+			Language: "python",
+			Metadata: map[string]string{"framework": "asv"},
+		}
+		if len(combination) > 0 {
+			result.Name += "(" + strings.Join(combination, ", ") + ")"
+			result.Metadata[MetadataGroup] = name
+			result.Metadata["params"] = strings.Join(combination, ", ")
+			// Parameter names are only in benchmarks.json, so values are labelled by position
+			for position, value := range combination {
+				result.Metadata[MetadataParamPrefix+strconv.Itoa(position)] = value
+			}
+		}
+		if b.Version != "" {
+			result.Metadata["version"] = b.Version
+		}
+
+		var samples []float64
+		if i < len(b.Samples) {
+			samples = b.Samples[i]
+		}
+		if repeat := asvColumn(b.Repeat, i); repeat != nil {
+			result.Iterations = int64(*repeat)
+			result.SampleCount = int64(*repeat)
+			result.Metadata["repeat"] = strconv.FormatFloat(*repeat, 'f', -1, 64)
+		} else {
+			result.Iterations = int64(len(samples))
+		}
+		if number := asvColumn(b.Number, i); number != nil {
+			result.Metadata["number"] = strconv.FormatFloat(*number, 'f', -1, 64)
+		}
+
+		value := *b.Result[i]
+		switch kind := asvBenchmarkKind(name); kind {
+		case "time", "timeraw":
+			// asv reports the median of the samples, in seconds
+			result.Time = secondsToDuration(value)
+			result.Metadata[MetadataMedianNs] = formatNanos(value * 1e9)
+			for _, sec := range samples {
+				result.Samples = append(result.Samples, secondsToDuration(sec))
+			}
+			if len(result.Samples) > 1 {
+				_, _, result.StdDev = summarizeSamples(result.Samples)
+			}
+			for key, column := range map[string][]*float64{
+				"ci_99_lower_ns": b.CI99A,
+				"ci_99_upper_ns": b.CI99B,
+				"q1_ns":          b.Q25,
+				"q3_ns":          b.Q75,
+			} {
+				if v := asvColumn(column, i); v != nil {
+					result.Metadata[key] = formatNanos(*v * 1e9)
+				}
+			}
+		case "mem", "peakmem":
+			metricName := map[string]string{"mem": "memory", "peakmem": "peak_memory"}[kind]
+			result.Metrics = []Metric{{Name: metricName, Unit: "B", Value: value, Samples: samples}}
+		default:
+			// track_ benchmarks report a value whose unit is only known to the benchmark
+			result.Metrics = []Metric{{Name: "value", Unit: "value", Value: value, Samples: samples}}
+		}
+
+		results = append(results, result)
+	}
+	return results
+}
+
+// asvBenchmarkKind returns the benchmark type from the method name prefix
+// ("time", "timeraw", "mem", "peakmem" or "track")
+func asvBenchmarkKind(name string) string {
+	method := name[strings.LastIndex(name, ".")+1:]
+	kind, _, _ := strings.Cut(method, "_")
+	return kind
+}
+
+// asvCombinations returns the parameter combinations in the order asv stores the
+// results (itertools.product, the first parameter varying slowest). Without
+// parameters there is a single empty combination.
+func asvCombinations(params [][]string) [][]string {
+	combinations := [][]string{nil}
+	for _, values := range params {
+		var next [][]string
+		for _, combination := range combinations {
+			for _, value := range values {
+				next = append(next, append(append([]string(nil), combination...), value))
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// asvColumn returns the i-th value of a statistics column, or nil if it is missing
+func asvColumn(column []*float64, i int) *float64 {
+	if i >= len(column) {
+		return nil
+	}
+	return column[i]
+}
+
+// addMetadata records the machine and environment as suite metadata
+func (r *asvResultsJSON) addMetadata(metadata map[string]string) {
+	// Machine parameters: arch, cpu, machine, num_cpu, os, ram, python, ...
+	for key, value := range r.Params {
+		if s := jsonScalarString(value); s != "" {
+			metadata[key] = s
+		}
+	}
+
+	for key, value := range map[string]string{
+		"commit_hash": r.CommitHash,
+		"env_name":    r.EnvName,
+		"python":      r.Python,
+	} {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+	if r.Date > 0 {
+		metadata["date"] = time.UnixMilli(r.Date).UTC().Format(time.RFC3339)
+	}
+
+	if len(r.Requirements) > 0 {
+		requirements := make([]string, 0, len(r.Requirements))
+		for name, version := range r.Requirements {
+			if v := jsonScalarString(version); v != "" {
+				name += "==" + v
+			}
+			requirements = append(requirements, name)
+		}
+		sort.Strings(requirements)
+		metadata["requirements"] = strings.Join(requirements, ", ")
+	}
+}
+
+// replaceJSONNonFinite replaces the NaN and Infinity literals Python's json module
+// writes by null, outside of strings, so the output can be decoded
+func replaceJSONNonFinite(data []byte) []byte {
+	var out []byte
+	inString, escaped := false, false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			out = append(out, c)
+			continue
+		}
+
+		if c == '"' {
+			inString = true
+		}
+		replaced := false
+		for _, literal := range []string{"NaN", "-Infinity", "Infinity"} {
+			if bytes.HasPrefix(data[i:], []byte(literal)) {
+				out = append(out, "null"...)
+				i += len(literal) - 1
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			out = append(out, c)
+		}
+	}
+	return out
+}
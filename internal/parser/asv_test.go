@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestAsvParser_Language(t *testing.T) {
+	if got := NewAsvParser().Language(); got != "python" {
+		t.Errorf("Language() = %v, want python", got)
+	}
+}
+
+func TestAsvParser_Results(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/python/asv_results.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+
+	suite, err := NewAsvParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	wantSuite := map[string]string{
+		"cpu":          "AMD Ryzen 9 5950X 16-Core Processor",
+		"machine":      "lab-01",
+		"num_cpu":      "32",
+		"arch":         "x86_64",
+		"commit_hash":  "4b1d2e3f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d",
+		"env_name":     "virtualenv-py3.11-numpy",
+		"python":       "3.11",
+		"date":         "2024-05-01T08:00:00Z",
+		"requirements": "numpy, scipy==1.11.4",
+	}
+	for key, want := range wantSuite {
+		if got := suite.Metadata[key]; got != want {
+			t.Errorf("suite.Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	// Sorted by name; time_sort's failed (null) and skipped (NaN) combinations are left out
+	wantNames := []string{
+		"benchmarks.MemSuite.peakmem_load",
+		"benchmarks.SortSuite.time_sort(100, 'quick')",
+		"benchmarks.SortSuite.time_sort(100, 'merge')",
+		"benchmarks.TimeSuite.time_add",
+		"benchmarks.TrackSuite.track_errors",
+	}
+	if len(suite.Results) != len(wantNames) {
+		t.Fatalf("len(Results) = %d, want %d", len(suite.Results), len(wantNames))
+	}
+	for i, want := range wantNames {
+		if suite.Results[i].Name != want {
+			t.Errorf("Results[%d].Name = %q, want %q", i, suite.Results[i].Name, want)
+		}
+	}
+
+	peakmem := suite.Results[0]
+	if peakmem.Time != 0 || len(peakmem.Metrics) != 1 || peakmem.Metrics[0].Name != "peak_memory" || peakmem.Metrics[0].Value != 104857600 {
+		t.Errorf("peakmem_load = %v, metrics %+v", peakmem.Time, peakmem.Metrics)
+	}
+
+	merge := suite.Results[2]
+	if merge.Time != 2*time.Millisecond || merge.Iterations != 5 {
+		t.Errorf("time_sort(100, 'merge') = %v over %d, want 2ms over 5", merge.Time, merge.Iterations)
+	}
+	if merge.Metadata["group"] != "benchmarks.SortSuite.time_sort" || merge.Metadata["params"] != "100, 'merge'" ||
+		merge.Metadata["param.0"] != "100" || merge.Metadata["param.1"] != "'merge'" ||
+		merge.Metadata["number"] != "1" || merge.Metadata["ci_99_lower_ns"] != "1900000" {
+		t.Errorf("time_sort(100, 'merge').Metadata = %v", merge.Metadata)
+	}
+
+	add := suite.Results[3]
+	if add.Time != 1500*time.Nanosecond || add.Iterations != 10 || add.Metadata["version"] != "0a1b2c3d" {
+		t.Errorf("time_add = %v over %d, metadata %v", add.Time, add.Iterations, add.Metadata)
+	}
+	if len(add.Samples) != 3 || add.Samples[2] != 1600*time.Nanosecond || add.StdDev != 100*time.Nanosecond {
+		t.Errorf("time_add samples = %v ± %v", add.Samples, add.StdDev)
+	}
+	if _, ok := add.Metadata["group"]; ok {
+		t.Errorf("time_add has a group without parameters: %v", add.Metadata)
+	}
+
+	track := suite.Results[4]
+	if len(track.Metrics) != 1 || track.Metrics[0].Name != "value" || track.Metrics[0].Value != 0.25 {
+		t.Errorf("track_errors metrics = %+v", track.Metrics)
+	}
+}
+
+func TestAsvCombinations(t *testing.T) {
+	got := asvCombinations([][]string{{"1", "2"}, {"a", "b", "c"}})
+	if len(got) != 6 {
+		t.Fatalf("len(combinations) = %d, want 6", len(got))
+	}
+	if got[0][0] != "1" || got[0][1] != "a" || got[2][1] != "c" || got[3][0] != "2" {
+		t.Errorf("combinations = %v, want the first parameter varying slowest", got)
+	}
+}
+
+func TestReplaceJSONNonFinite(t *testing.T) {
+	input := `{"a": [NaN, -Infinity, Infinity, 1.5], "b": "NaN \"Infinity\""}`
+	want := `{"a": [null, null, null, 1.5], "b": "NaN \"Infinity\""}`
+	if got := string(replaceJSONNonFinite([]byte(input))); got != want {
+		t.Errorf("replaceJSONNonFinite() = %s, want %s", got, want)
+	}
+}
+
+func TestAsvParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "invalid JSON", input: "not json"},
+		{name: "version 1", input: `{"version": 1, "results": {"b.time_x": 1.0}}`},
+		{name: "all failed", input: `{"version": 2, "result_columns": ["result", "params"], "results": {"b.time_x": [null, []]}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewAsvParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
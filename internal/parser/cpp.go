@@ -0,0 +1,373 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GoogleBenchmarkParser implements Parser for Google Benchmark (C/C++) JSON output
+// produced with --benchmark_format=json or --benchmark_out
+type GoogleBenchmarkParser struct{}
+
+// NewGoogleBenchmarkParser creates a new Google Benchmark parser
+func NewGoogleBenchmarkParser() *GoogleBenchmarkParser {
+	return &GoogleBenchmarkParser{}
+}
+
+// Language returns the language this parser supports
+func (p *GoogleBenchmarkParser) Language() string {
+	return "cpp"
+}
+
+// googleBenchmarkJSON represents the structure of Google Benchmark JSON output
+type googleBenchmarkJSON struct {
+	Context    *googleBenchmarkContext `json:"context"`
+	Benchmarks []json.RawMessage       `json:"benchmarks"`
+}
+
+// googleBenchmarkContext describes the machine the benchmarks ran on
+type googleBenchmarkContext struct {
+	Date              string                 `json:"date"`
+	HostName          string                 `json:"host_name"`
+	Executable        string                 `json:"executable"`
+	NumCPUs           int                    `json:"num_cpus"`
+	MHzPerCPU         float64                `json:"mhz_per_cpu"`
+	CPUScalingEnabled *bool                  `json:"cpu_scaling_enabled"`
+	Caches            []googleBenchmarkCache `json:"caches"`
+	LibraryBuildType  string                 `json:"library_build_type"`
+}
+
+// googleBenchmarkCache is one CPU cache level reported in the context
+type googleBenchmarkCache struct {
+	Type       string `json:"type"`
+	Level      int    `json:"level"`
+	Size       int64  `json:"size"`
+	NumSharing int    `json:"num_sharing"`
+}
+
+// googleBenchmarkRun is one entry of the "benchmarks" array: a single run
+// (run_type "iteration") or an aggregate over repetitions (run_type "aggregate")
+type googleBenchmarkRun struct {
+	Name           string  `json:"name"`
+	RunName        string  `json:"run_name"`
+	RunType        string  `json:"run_type"`
+	AggregateName  string  `json:"aggregate_name"`
+	AggregateUnit  string  `json:"aggregate_unit"` // "time" or "percentage" (cv)
+	Repetitions    int     `json:"repetitions"`
+	Threads        int     `json:"threads"`
+	Iterations     int64   `json:"iterations"`
+	RealTime       float64 `json:"real_time"`
+	CPUTime        float64 `json:"cpu_time"`
+	TimeUnit       string  `json:"time_unit"`
+	BytesPerSecond float64 `json:"bytes_per_second"`
+	ItemsPerSecond float64 `json:"items_per_second"`
+	Label          string  `json:"label"`
+	ErrorOccurred  bool    `json:"error_occurred"`
+	ErrorMessage   string  `json:"error_message"`
+
+	counters map[string]float64 // User counters (any other numeric field)
+}
+
+// googleBenchmarkFields are the fields of a run that are not user counters
+var googleBenchmarkFields = map[string]bool{
+	"name": true, "family_index": true, "per_family_instance_index": true, "run_name": true,
+	"run_type": true, "repetitions": true, "repetition_index": true, "threads": true,
+	"iterations": true, "real_time": true, "cpu_time": true, "time_unit": true,
+	"bytes_per_second": true, "items_per_second": true, "label": true, "error_occurred": true,
+	"error_message": true, "aggregate_name": true, "aggregate_unit": true, "big_o": true,
+	"rms": true, "complexity_n": true,
+}
+
+// googleBenchmarkTimeUnits maps time_unit to nanoseconds
+var googleBenchmarkTimeUnits = map[string]float64{
+	"":   1,
+	"ns": 1,
+	"us": 1e3,
+	"ms": 1e6,
+	"s":  1e9,
+}
+
+// googleBenchmark collects the runs and aggregates of one benchmark (one run_name)
+type googleBenchmark struct {
+	name       string
+	runs       []*googleBenchmarkRun
+	aggregates map[string]*googleBenchmarkRun // aggregate_name -> aggregate
+}
+
+// Parse parses Google Benchmark JSON output.
+// Runs are grouped by run_name; repetitions become samples and the mean, median,
+// stddev and cv aggregates are used when present.
+func (p *GoogleBenchmarkParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data googleBenchmarkJSON
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "cpp",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+	if data.Context != nil {
+		data.Context.addMetadata(suite.Metadata)
+	}
+
+	var benchmarks []*googleBenchmark
+	byName := make(map[string]*googleBenchmark)
+	for i, raw := range data.Benchmarks {
+		run, err := parseGoogleBenchmarkRun(raw)
+		if err != nil {
+			return nil, &ParseError{
+				Line:    i + 1,
+				Message: err.Error(),
+				Input:   string(raw),
+			}
+		}
+		if run.ErrorOccurred {
+			continue
+		}
+
+		name := run.RunName
+		if name == "" {
+			name = run.Name
+		}
+		bench, ok := byName[name]
+		if !ok {
+			bench = &googleBenchmark{name: name, aggregates: make(map[string]*googleBenchmarkRun)}
+			byName[name] = bench
+			benchmarks = append(benchmarks, bench)
+		}
+
+		if run.RunType == "aggregate" {
+			bench.aggregates[run.AggregateName] = run
+		} else {
+			bench.runs = append(bench.runs, run)
+		}
+	}
+
+	for _, bench := range benchmarks {
+		if result := bench.result(); result != nil {
+			suite.Results = append(suite.Results, result)
+		}
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// parseGoogleBenchmarkRun decodes one benchmark entry, converting its times to
+// nanoseconds and collecting the user counters
+func parseGoogleBenchmarkRun(raw json.RawMessage) (*googleBenchmarkRun, error) {
+	var run googleBenchmarkRun
+	if err := json.Unmarshal(raw, &run); err != nil {
+		return nil, fmt.Errorf("invalid benchmark entry: %v", err)
+	}
+
+	scale, ok := googleBenchmarkTimeUnits[run.TimeUnit]
+	if !ok {
+		return nil, fmt.Errorf("unsupported time unit %q in %s", run.TimeUnit, run.Name)
+	}
+	// The cv aggregate is a ratio, not a time
+	if run.AggregateUnit != "percentage" {
+		run.RealTime *= scale
+		run.CPUTime *= scale
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("invalid benchmark entry: %v", err)
+	}
+	for key, value := range fields {
+		if v, ok := value.(float64); ok && !googleBenchmarkFields[key] {
+			if run.counters == nil {
+				run.counters = make(map[string]float64)
+			}
+			run.counters[key] = v
+		}
+	}
+
+	return &run, nil
+}
+
+// result builds a BenchmarkResult from the runs and aggregates of one benchmark.
+// It returns nil for complexity-only entries (BigO/RMS) without runs or a mean.
+func (b *googleBenchmark) result() *BenchmarkResult {
+	mean := b.aggregates["mean"]
+	if len(b.runs) == 0 && mean == nil {
+		return nil
+	}
+
+	result := &BenchmarkResult{
+		Name:     b.name,
+		Language: "cpp",
+		Metadata: map[string]string{"framework": "google-benchmark"},
+	}
+
+	// Per-run values, or the mean aggregate when only aggregates were reported
+	sources := b.runs
+	if len(sources) == 0 {
+		sources = []*googleBenchmarkRun{mean}
+	}
+
+	var realTimes, cpuTimes, bytesPerSecond, itemsPerSecond []float64
+	counters := make(map[string][]float64)
+	for _, run := range sources {
+		realTimes = append(realTimes, run.RealTime)
+		cpuTimes = append(cpuTimes, run.CPUTime)
+		if run.BytesPerSecond > 0 {
+			bytesPerSecond = append(bytesPerSecond, run.BytesPerSecond)
+		}
+		if run.ItemsPerSecond > 0 {
+			itemsPerSecond = append(itemsPerSecond, run.ItemsPerSecond)
+		}
+		for name, value := range run.counters {
+			counters[name] = append(counters[name], value)
+		}
+	}
+
+	// Repetitions are the independent samples; iterations are loop counts within each
+	// one. An aggregate's iterations are the repetition count, so they're not used.
+	var totalIterations int64
+	for _, run := range b.runs {
+		totalIterations += run.Iterations
+	}
+	if len(b.runs) > 0 {
+		result.Iterations = totalIterations / int64(len(b.runs))
+	}
+	switch {
+	case len(b.runs) > 1:
+		result.SampleCount = int64(len(b.runs))
+		result.Metadata["total_iterations"] = strconv.FormatInt(totalIterations, 10)
+	case mean != nil && mean.Repetitions > 1:
+		result.SampleCount = int64(mean.Repetitions)
+	}
+
+	if len(b.runs) > 1 {
+		for _, ns := range realTimes {
+			result.Samples = append(result.Samples, time.Duration(math.Round(ns)))
+		}
+	}
+
+	// Time is the mean real (wall-clock) time
+	meanNs := meanFloat64(realTimes)
+	if mean != nil {
+		meanNs = mean.RealTime
+	}
+	result.Time = time.Duration(math.Round(meanNs))
+
+	if stddev := b.aggregates["stddev"]; stddev != nil {
+		result.StdDev = time.Duration(math.Round(stddev.RealTime))
+	} else if len(result.Samples) > 1 {
+		_, _, result.StdDev = summarizeSamples(result.Samples)
+	}
+
+	if median := b.aggregates["median"]; median != nil {
+		result.Metadata[MetadataMedianNs] = formatNanos(median.RealTime)
+	} else if len(result.Samples) > 1 {
+		_, median, _ := summarizeSamples(result.Samples)
+		result.Metadata[MetadataMedianNs] = formatNanos(float64(median))
+	}
+	if cv := b.aggregates["cv"]; cv != nil {
+		result.Metadata["cv"] = strconv.FormatFloat(cv.RealTime, 'f', -1, 64)
+	}
+
+	// CPU time is compared as a metric of its own
+	cpuMetric := Metric{Name: "cpu_time", Unit: "ns/op", Value: meanFloat64(cpuTimes)}
+	if mean != nil {
+		cpuMetric.Value = mean.CPUTime
+	}
+	if len(b.runs) > 1 {
+		cpuMetric.Samples = cpuTimes
+	}
+	result.Metrics = append(result.Metrics, cpuMetric)
+
+	// SetBytesProcessed is the throughput; SetItemsProcessed is used when no bytes are set
+	switch {
+	case len(bytesPerSecond) > 0:
+		result.Throughput = &Throughput{Value: meanFloat64(bytesPerSecond) / 1e6, Unit: "MB/s"}
+		if len(itemsPerSecond) > 0 {
+			result.Metrics = append(result.Metrics, Metric{Name: "items_per_second", Unit: "elem/s", Value: meanFloat64(itemsPerSecond), HigherIsBetter: true})
+		}
+	case len(itemsPerSecond) > 0:
+		result.Throughput = &Throughput{Value: meanFloat64(itemsPerSecond), Unit: "elem/s"}
+	}
+
+	names := make([]string, 0, len(counters))
+	for name := range counters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		metric := Metric{
+			Name:           name,
+			Unit:           name,
+			Value:          meanFloat64(counters[name]),
+			HigherIsBetter: strings.HasSuffix(name, "/s") || strings.HasSuffix(name, "_per_second"),
+		}
+		if len(counters[name]) > 1 {
+			metric.Samples = counters[name]
+		}
+		result.Metrics = append(result.Metrics, metric)
+	}
+
+	first := sources[0]
+	if first.Threads > 0 {
+		result.Metadata["threads"] = strconv.Itoa(first.Threads)
+	}
+	if first.Repetitions > 0 {
+		result.Metadata["repetitions"] = strconv.Itoa(first.Repetitions)
+	}
+	if first.Label != "" {
+		result.Metadata["label"] = first.Label
+	}
+
+	return result
+}
+
+// addMetadata records the benchmark context as suite metadata
+func (c *googleBenchmarkContext) addMetadata(metadata map[string]string) {
+	if c.Date != "" {
+		metadata["date"] = c.Date
+	}
+	if c.HostName != "" {
+		metadata["host_name"] = c.HostName
+	}
+	if c.Executable != "" {
+		metadata["executable"] = c.Executable
+	}
+	if c.NumCPUs > 0 {
+		metadata["num_cpus"] = strconv.Itoa(c.NumCPUs)
+	}
+	if c.MHzPerCPU > 0 {
+		metadata["mhz_per_cpu"] = strconv.FormatFloat(c.MHzPerCPU, 'f', -1, 64)
+	}
+	if c.CPUScalingEnabled != nil {
+		metadata["cpu_scaling_enabled"] = strconv.FormatBool(*c.CPUScalingEnabled)
+	}
+	if c.LibraryBuildType != "" {
+		metadata["library_build_type"] = c.LibraryBuildType
+	}
+
+	// e.g. "L1 Data 32 KiB (x4), L2 Unified 256 KiB (x4)", as the console reporter prints them
+	var caches []string
+	for _, cache := range c.Caches {
+		caches = append(caches, fmt.Sprintf("L%d %s %d KiB (x%d)", cache.Level, cache.Type, cache.Size/1024, max(c.NumCPUs/max(cache.NumSharing, 1), 1)))
+	}
+	if len(caches) > 0 {
+		metadata["caches"] = strings.Join(caches, ", ")
+	}
+}
@@ -0,0 +1,176 @@
+package parser
+
+import (
+	"math"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGoogleBenchmarkParser_Language(t *testing.T) {
+	if got := NewGoogleBenchmarkParser().Language(); got != "cpp" {
+		t.Errorf("Language() = %v, want cpp", got)
+	}
+}
+
+func TestGoogleBenchmarkParser_Repetitions(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/cpp/google_benchmark_repetitions.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+
+	suite, err := NewGoogleBenchmarkParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	wantSuite := map[string]string{
+		"num_cpus":            "8",
+		"mhz_per_cpu":         "3600",
+		"cpu_scaling_enabled": "false",
+		"host_name":           "build-01",
+		"library_build_type":  "release",
+		"caches":              "L1 Data 32 KiB (x4), L1 Instruction 32 KiB (x4), L2 Unified 256 KiB (x4), L3 Unified 8192 KiB (x1)",
+	}
+	for key, want := range wantSuite {
+		if got := suite.Metadata[key]; got != want {
+			t.Errorf("suite.Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	// The errored BM_Broken run is skipped
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+
+	sort := suite.Results[0]
+	if sort.Name != "BM_Sort/1024" || sort.Language != "cpp" {
+		t.Errorf("Results[0] = %q (%s), want BM_Sort/1024 (cpp)", sort.Name, sort.Language)
+	}
+	if sort.Time != 11*time.Microsecond {
+		t.Errorf("Time = %v, want 11µs", sort.Time)
+	}
+	if sort.StdDev != time.Microsecond {
+		t.Errorf("StdDev = %v, want 1µs", sort.StdDev)
+	}
+	if sort.Iterations != 10000 || sort.SampleCount != 3 || sort.Metadata["total_iterations"] != "30000" {
+		t.Errorf("Iterations = %d, SampleCount = %d, total_iterations = %q, want 10000 per repetition, 3 repetitions, 30000 in total",
+			sort.Iterations, sort.SampleCount, sort.Metadata["total_iterations"])
+	}
+	wantSamples := []time.Duration{10 * time.Microsecond, 12 * time.Microsecond, 11 * time.Microsecond}
+	if len(sort.Samples) != len(wantSamples) {
+		t.Fatalf("Samples = %v, want %v", sort.Samples, wantSamples)
+	}
+	for i, want := range wantSamples {
+		if sort.Samples[i] != want {
+			t.Errorf("Samples[%d] = %v, want %v", i, sort.Samples[i], want)
+		}
+	}
+	if sort.Metadata["median_ns"] != "11000" || sort.Metadata["cv"] != "0.0909" || sort.Metadata["repetitions"] != "3" {
+		t.Errorf("Metadata = %v", sort.Metadata)
+	}
+	if sort.Throughput == nil || sort.Throughput.Unit != "MB/s" || math.Abs(sort.Throughput.Value-374.432323) > 1e-6 {
+		t.Errorf("Throughput = %+v, want ~374.43 MB/s", sort.Throughput)
+	}
+	if len(sort.Metrics) != 2 {
+		t.Fatalf("Metrics = %+v, want cpu_time and comparisons", sort.Metrics)
+	}
+	if m := sort.Metrics[0]; m.Name != "cpu_time" || m.Unit != "ns/op" || m.Value != 10500 || len(m.Samples) != 3 {
+		t.Errorf("Metrics[0] = %+v, want cpu_time 10500 ns/op with 3 samples", m)
+	}
+	if m := sort.Metrics[1]; m.Name != "comparisons" || m.Value != 10240 || m.HigherIsBetter {
+		t.Errorf("Metrics[1] = %+v, want comparisons counter 10240", m)
+	}
+
+	hash := suite.Results[1]
+	if hash.Time != 351*time.Nanosecond || hash.Samples != nil || hash.StdDev != 0 {
+		t.Errorf("Results[1] time = %v, samples = %v, stddev = %v", hash.Time, hash.Samples, hash.StdDev)
+	}
+	if hash.Throughput == nil || hash.Throughput.Unit != "elem/s" || hash.Throughput.Value != 2856532.3 {
+		t.Errorf("Results[1].Throughput = %+v, want 2856532.3 elem/s", hash.Throughput)
+	}
+	if hash.Metadata["threads"] != "4" || hash.Metadata["label"] != "fnv1a" {
+		t.Errorf("Results[1].Metadata = %v", hash.Metadata)
+	}
+	if hash.Metrics[0].Value != 1400.25 {
+		t.Errorf("cpu_time = %v, want 1400.25", hash.Metrics[0].Value)
+	}
+}
+
+func TestGoogleBenchmarkParser_AggregatesOnly(t *testing.T) {
+	input := []byte(`{
+  "benchmarks": [
+    {"name": "BM_Copy_mean", "run_name": "BM_Copy", "run_type": "aggregate", "repetitions": 5, "threads": 1,
+     "aggregate_name": "mean", "aggregate_unit": "time", "iterations": 5, "real_time": 2.5, "cpu_time": 2.4, "time_unit": "ms"},
+    {"name": "BM_Copy_stddev", "run_name": "BM_Copy", "run_type": "aggregate", "repetitions": 5, "threads": 1,
+     "aggregate_name": "stddev", "aggregate_unit": "time", "iterations": 5, "real_time": 0.1, "cpu_time": 0.1, "time_unit": "ms"}
+  ]
+}`)
+
+	suite, err := NewGoogleBenchmarkParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1", len(suite.Results))
+	}
+
+	result := suite.Results[0]
+	if result.Name != "BM_Copy" || result.Time != 2500*time.Microsecond || result.StdDev != 100*time.Microsecond {
+		t.Errorf("Result = %q %v ± %v, want BM_Copy 2.5ms ± 100µs", result.Name, result.Time, result.StdDev)
+	}
+	if result.Samples != nil {
+		t.Errorf("Samples = %v, want none without per-repetition runs", result.Samples)
+	}
+	if result.SampleCount != 5 || result.Iterations != 0 {
+		t.Errorf("SampleCount = %d, Iterations = %d, want 5 repetitions and unknown iterations", result.SampleCount, result.Iterations)
+	}
+}
+
+func TestGoogleBenchmarkParser_BytesAndItems(t *testing.T) {
+	input := []byte(`{
+  "benchmarks": [
+    {"name": "BM_Decode", "run_name": "BM_Decode", "run_type": "iteration", "iterations": 1000,
+     "real_time": 500, "cpu_time": 498, "time_unit": "ns", "bytes_per_second": 2048000000, "items_per_second": 2000000}
+  ]
+}`)
+
+	suite, err := NewGoogleBenchmarkParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	result := suite.Results[0]
+	if result.Throughput == nil || result.Throughput.Unit != "MB/s" || result.Throughput.Value != 2048 {
+		t.Errorf("Throughput = %+v, want 2048 MB/s", result.Throughput)
+	}
+	var items *Metric
+	for i := range result.Metrics {
+		if result.Metrics[i].Name == "items_per_second" {
+			items = &result.Metrics[i]
+		}
+	}
+	if items == nil || items.Unit != "elem/s" || items.Value != 2000000 || !items.HigherIsBetter {
+		t.Errorf("items_per_second = %+v, want 2000000 elem/s, higher is better", items)
+	}
+}
+
+func TestGoogleBenchmarkParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "invalid JSON", input: "not json"},
+		{name: "no benchmarks", input: `{"context": {"num_cpus": 8}, "benchmarks": []}`},
+		{name: "only errors", input: `{"benchmarks": [{"name": "BM_X", "run_type": "iteration", "error_occurred": true, "time_unit": "ns"}]}`},
+		{name: "unknown time unit", input: `{"benchmarks": [{"name": "BM_X", "run_type": "iteration", "iterations": 1, "real_time": 1, "cpu_time": 1, "time_unit": "ps"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewGoogleBenchmarkParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
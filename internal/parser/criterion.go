@@ -0,0 +1,428 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CriterionParser implements Parser for Criterion.rs results reported by
+// `cargo criterion --message-format=json`. Results saved under target/criterion
+// are read with ParseCriterionDirectory.
+type CriterionParser struct{}
+
+// NewCriterionParser creates a new Criterion.rs benchmark parser
+func NewCriterionParser() *CriterionParser {
+	return &CriterionParser{}
+}
+
+// Language returns the language this parser supports
+func (p *CriterionParser) Language() string {
+	return "rust"
+}
+
+// criterionMessage is one line of `cargo criterion --message-format=json` output
+type criterionMessage struct {
+	Reason         string                       `json:"reason"` // "benchmark-complete" or "group-complete"
+	ID             string                       `json:"id"`
+	IterationCount []float64                    `json:"iteration_count"`
+	MeasuredValues []float64                    `json:"measured_values"` // Total time of each sample
+	Unit           string                       `json:"unit"`
+	Throughput     []criterionMessageThroughput `json:"throughput"`
+	Mean           *criterionMessageEstimate    `json:"mean"`
+	Median         *criterionMessageEstimate    `json:"median"`
+	GroupName      string                       `json:"group_name"`
+	BenchmarkIDs   []string                     `json:"benchmark_ids"`
+}
+
+// criterionMessageEstimate is a point estimate with its confidence interval
+type criterionMessageEstimate struct {
+	Estimate   float64 `json:"estimate"`
+	LowerBound float64 `json:"lower_bound"`
+	UpperBound float64 `json:"upper_bound"`
+	Unit       string  `json:"unit"`
+}
+
+// criterionMessageThroughput is the amount of work done per iteration
+type criterionMessageThroughput struct {
+	PerIteration float64 `json:"per_iteration"`
+	Unit         string  `json:"unit"` // "bytes" or "elements"
+}
+
+// criterionBenchmarkJSON represents Criterion.rs benchmark.json (target/criterion/<id>/new/benchmark.json)
+type criterionBenchmarkJSON struct {
+	GroupID    string             `json:"group_id"`
+	FunctionID *string            `json:"function_id"`
+	ValueStr   *string            `json:"value_str"`
+	Throughput map[string]float64 `json:"throughput"` // {"Bytes": n}, {"BytesDecimal": n} or {"Elements": n}
+	FullID     string             `json:"full_id"`
+}
+
+// criterionEstimatesJSON represents Criterion.rs estimates.json (target/criterion/<id>/new/estimates.json)
+type criterionEstimatesJSON struct {
+	Mean   *criterionEstimate `json:"mean"`
+	Median *criterionEstimate `json:"median"`
+	StdDev *criterionEstimate `json:"std_dev"`
+}
+
+// criterionEstimate is a bootstrapped statistic in estimates.json
+type criterionEstimate struct {
+	ConfidenceInterval struct {
+		ConfidenceLevel float64 `json:"confidence_level"`
+		LowerBound      float64 `json:"lower_bound"`
+		UpperBound      float64 `json:"upper_bound"`
+	} `json:"confidence_interval"`
+	PointEstimate float64 `json:"point_estimate"`
+	StandardError float64 `json:"standard_error"`
+}
+
+// criterionSampleJSON represents Criterion.rs sample.json (target/criterion/<id>/new/sample.json)
+type criterionSampleJSON struct {
+	SamplingMode string    `json:"sampling_mode"`
+	Iters        []float64 `json:"iters"`
+	Times        []float64 `json:"times"` // Total nanoseconds for each sample's iterations
+}
+
+// criterionBenchmark collects what Criterion reports about one benchmark, in nanoseconds
+type criterionBenchmark struct {
+	id              string
+	group           string
+	function        string
+	parameter       string
+	mean            float64
+	median          float64
+	stdDev          float64
+	meanLower       float64
+	meanUpper       float64
+	confidenceLevel float64 // 0 when not reported
+	samples         []time.Duration
+	iterations      int64
+	bytes           float64 // bytes processed per iteration, 0 when not set
+	elements        float64 // elements processed per iteration, 0 when not set
+}
+
+// Parse parses `cargo criterion --message-format=json` output.
+// Each "benchmark-complete" message becomes a result named after its id
+// ("group/function/parameter"); "group-complete" messages provide the group names.
+// Lines that are not JSON messages are ignored.
+func (p *CriterionParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	suite := &BenchmarkSuite{
+		Language:  "rust",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	var benchmarks []*criterionBenchmark
+	groups := make(map[string]string) // benchmark id -> group name
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+
+		var msg criterionMessage
+		if err := json.Unmarshal(line, &msg); err != nil {
+			continue
+		}
+
+		switch msg.Reason {
+		case "benchmark-complete":
+			bench, err := criterionBenchmarkFromMessage(&msg)
+			if err != nil {
+				return nil, &ParseError{
+					Line:    lineNum,
+					Message: err.Error(),
+					Input:   msg.ID,
+				}
+			}
+			benchmarks = append(benchmarks, bench)
+		case "group-complete":
+			for _, id := range msg.BenchmarkIDs {
+				groups[id] = msg.GroupName
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %w", err)
+	}
+
+	for _, bench := range benchmarks {
+		bench.group, bench.function, bench.parameter = splitCriterionID(bench.id, groups[bench.id])
+		suite.Results = append(suite.Results, bench.result())
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// criterionBenchmarkFromMessage converts a "benchmark-complete" message
+func criterionBenchmarkFromMessage(msg *criterionMessage) (*criterionBenchmark, error) {
+	if msg.Unit != "" && msg.Unit != "ns" {
+		return nil, fmt.Errorf("unsupported measurement unit %q (only wall-clock time in ns is supported)", msg.Unit)
+	}
+
+	samples, err := criterionPerIterationSamples(msg.IterationCount, msg.MeasuredValues)
+	if err != nil {
+		return nil, err
+	}
+
+	mean, median, stdDev := summarizeSamples(samples)
+	bench := &criterionBenchmark{
+		id:     msg.ID,
+		mean:   float64(mean),
+		median: float64(median),
+		stdDev: float64(stdDev),
+	}
+	if msg.Mean != nil {
+		bench.mean = msg.Mean.Estimate
+		bench.meanLower = msg.Mean.LowerBound
+		bench.meanUpper = msg.Mean.UpperBound
+	}
+	if msg.Median != nil {
+		bench.median = msg.Median.Estimate
+	}
+
+	bench.samples = samples
+	for _, iters := range msg.IterationCount {
+		bench.iterations += int64(iters)
+	}
+
+	for _, t := range msg.Throughput {
+		switch t.Unit {
+		case "bytes":
+			bench.bytes = t.PerIteration
+		case "elements":
+			bench.elements = t.PerIteration
+		}
+	}
+
+	return bench, nil
+}
+
+// splitCriterionID splits a benchmark id into group, function and parameter.
+// Without a known group, the first path segment is the group.
+func splitCriterionID(id, group string) (string, string, string) {
+	rest := id
+	if group != "" && strings.HasPrefix(id, group+"/") {
+		rest = strings.TrimPrefix(id, group+"/")
+	} else if group == "" {
+		group, rest, _ = strings.Cut(id, "/")
+	} else {
+		// The id is the group itself (a single bench_function)
+		return group, "", ""
+	}
+
+	function, parameter, _ := strings.Cut(rest, "/")
+	return group, function, parameter
+}
+
+// ParseCriterionDirectory reads the results Criterion.rs saved under dir (usually target/criterion).
+// Every <id>/new directory with a benchmark.json and estimates.json becomes a result; the
+// sample.json next to them, when present, provides the raw samples.
+func ParseCriterionDirectory(dir string) (*BenchmarkSuite, error) {
+	suite := &BenchmarkSuite{
+		Language:  "rust",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != "benchmark.json" || filepath.Base(filepath.Dir(path)) != "new" {
+			return nil
+		}
+
+		bench, err := readCriterionBenchmark(filepath.Dir(path))
+		if err != nil {
+			return fmt.Errorf("%s: %w", filepath.Dir(path), err)
+		}
+		suite.Results = append(suite.Results, bench.result())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("no Criterion results found in %s", dir),
+		}
+	}
+
+	return suite, nil
+}
+
+// readCriterionBenchmark reads benchmark.json, estimates.json and the optional sample.json of one result
+func readCriterionBenchmark(dir string) (*criterionBenchmark, error) {
+	var info criterionBenchmarkJSON
+	if err := readCriterionJSON(filepath.Join(dir, "benchmark.json"), &info); err != nil {
+		return nil, err
+	}
+
+	var estimates criterionEstimatesJSON
+	if err := readCriterionJSON(filepath.Join(dir, "estimates.json"), &estimates); err != nil {
+		return nil, err
+	}
+	if estimates.Mean == nil {
+		return nil, &ParseError{Message: "estimates.json has no mean estimate"}
+	}
+
+	bench := &criterionBenchmark{
+		id:              info.FullID,
+		group:           info.GroupID,
+		mean:            estimates.Mean.PointEstimate,
+		meanLower:       estimates.Mean.ConfidenceInterval.LowerBound,
+		meanUpper:       estimates.Mean.ConfidenceInterval.UpperBound,
+		confidenceLevel: estimates.Mean.ConfidenceInterval.ConfidenceLevel,
+		median:          estimates.Mean.PointEstimate,
+		bytes:           info.Throughput["Bytes"] + info.Throughput["BytesDecimal"],
+		elements:        info.Throughput["Elements"],
+	}
+	if info.FunctionID != nil {
+		bench.function = *info.FunctionID
+	}
+	if info.ValueStr != nil {
+		bench.parameter = *info.ValueStr
+	}
+	if estimates.Median != nil {
+		bench.median = estimates.Median.PointEstimate
+	}
+	if estimates.StdDev != nil {
+		bench.stdDev = estimates.StdDev.PointEstimate
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "sample.json"))
+	switch {
+	case err == nil:
+		var raw criterionSampleJSON
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, &ParseError{Message: fmt.Sprintf("failed to parse sample JSON: %v", err)}
+		}
+		if bench.samples, err = criterionPerIterationSamples(raw.Iters, raw.Times); err != nil {
+			return nil, err
+		}
+		for _, iters := range raw.Iters {
+			bench.iterations += int64(iters)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("failed to read sample.json: %w", err)
+	}
+
+	return bench, nil
+}
+
+// readCriterionJSON reads and decodes one of Criterion's JSON files
+func readCriterionJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return &ParseError{Message: fmt.Sprintf("failed to parse %s: %v", filepath.Base(path), err)}
+	}
+	return nil
+}
+
+// result builds a BenchmarkResult from the Criterion estimates
+func (b *criterionBenchmark) result() *BenchmarkResult {
+	result := &BenchmarkResult{
+		Name:       b.id,
+		Language:   "rust",
+		Time:       time.Duration(math.Round(b.mean)),
+		Iterations: b.iterations,
+		StdDev:     time.Duration(math.Round(b.stdDev)),
+		Samples:    b.samples,
+		Metadata:   map[string]string{"framework": "criterion"},
+	}
+
+	result.Metadata[MetadataMedianNs] = formatNanos(b.median)
+	if b.meanLower != 0 || b.meanUpper != 0 {
+		result.Metadata["mean_lower_ns"] = formatNanos(b.meanLower)
+		result.Metadata["mean_upper_ns"] = formatNanos(b.meanUpper)
+	}
+	if b.confidenceLevel > 0 {
+		result.Metadata["confidence_level"] = strconv.FormatFloat(b.confidenceLevel, 'f', -1, 64)
+	}
+
+	// The parameter is Criterion's value_str, which has no name of its own
+	for key, value := range map[string]string{
+		MetadataGroup:                 b.group,
+		"function":                    b.function,
+		MetadataParamPrefix + "value": b.parameter,
+	} {
+		if value != "" {
+			result.Metadata[key] = value
+		}
+	}
+
+	// Throughput declared with group.throughput(...), derived from the mean time.
+	// MB/s matches the unit libtest and Go report (10^6 bytes per second).
+	if b.mean > 0 {
+		switch {
+		case b.bytes > 0:
+			result.Throughput = &Throughput{Value: b.bytes / b.mean * 1e3, Unit: "MB/s"}
+		case b.elements > 0:
+			result.Throughput = &Throughput{Value: b.elements / b.mean * 1e9, Unit: "elem/s"}
+		}
+	}
+
+	return result
+}
+
+// formatNanos formats a nanosecond value without trailing zeros
+func formatNanos(ns float64) string {
+	return strconv.FormatFloat(ns, 'f', -1, 64)
+}
+
+// criterionPerIterationSamples divides each sample's total time by its iteration count
+func criterionPerIterationSamples(iters, times []float64) ([]time.Duration, error) {
+	if len(iters) != len(times) {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("sample iters and times length mismatch: %d != %d", len(iters), len(times)),
+		}
+	}
+
+	if len(times) == 0 {
+		return nil, &ParseError{
+			Message: "no samples found in sample JSON",
+		}
+	}
+
+	samples := make([]time.Duration, 0, len(times))
+	for i, total := range times {
+		n := iters[i]
+		if n <= 0 || total < 0 {
+			return nil, &ParseError{
+				Line:    i + 1,
+				Message: fmt.Sprintf("invalid sample: %f ns over %f iterations", total, n),
+			}
+		}
+		samples = append(samples, time.Duration(math.Round(total/n)))
+	}
+
+	return samples, nil
+}
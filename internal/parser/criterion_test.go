@@ -0,0 +1,190 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCriterionParser_Language(t *testing.T) {
+	if got := NewCriterionParser().Language(); got != "rust" {
+		t.Errorf("Language() = %v, want rust", got)
+	}
+}
+
+func TestCriterionParser_Parse_Messages(t *testing.T) {
+	input := []byte(`    Compiling sort v0.1.0
+{"reason":"benchmark-complete","id":"sort/quick/1000","report_directory":"target/criterion/reports/sort/quick/1000","iteration_count":[10,20,30],"measured_values":[1000.0,2200.0,2700.0],"unit":"ns","throughput":[{"per_iteration":4000,"unit":"bytes"}],"typical":{"estimate":99.0,"lower_bound":95.0,"upper_bound":103.0,"unit":"ns"},"mean":{"estimate":100.0,"lower_bound":96.5,"upper_bound":104.25,"unit":"ns"},"median":{"estimate":98.0,"lower_bound":95.0,"upper_bound":101.0,"unit":"ns"},"median_abs_dev":{"estimate":3.0,"lower_bound":2.0,"upper_bound":4.0,"unit":"ns"},"slope":null,"change":null}
+{"reason":"benchmark-complete","id":"sort/merge/1000","report_directory":"target/criterion/reports/sort/merge/1000","iteration_count":[5,5],"measured_values":[1000.0,1000.0],"unit":"ns","throughput":[{"per_iteration":1000,"unit":"elements"}],"mean":{"estimate":200.0,"lower_bound":200.0,"upper_bound":200.0,"unit":"ns"},"median":{"estimate":200.0,"lower_bound":200.0,"upper_bound":200.0,"unit":"ns"}}
+{"reason":"group-complete","group_name":"sort","benchmark_ids":["sort/quick/1000","sort/merge/1000"],"report_directory":"target/criterion/reports/sort"}
+{"reason":"benchmark-complete","id":"fib 20","iteration_count":[100],"measured_values":[5000.0],"unit":"ns","throughput":[],"mean":{"estimate":50.0,"lower_bound":49.0,"upper_bound":51.0,"unit":"ns"},"median":{"estimate":50.0,"lower_bound":49.0,"upper_bound":51.0,"unit":"ns"}}
+`)
+
+	suite, err := NewCriterionParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(suite.Results))
+	}
+
+	quick := suite.Results[0]
+	if quick.Name != "sort/quick/1000" || quick.Language != "rust" {
+		t.Errorf("Results[0] = %s (%s), want sort/quick/1000 (rust)", quick.Name, quick.Language)
+	}
+	if quick.Time != 100*time.Nanosecond {
+		t.Errorf("Time = %v, want mean estimate 100ns", quick.Time)
+	}
+	if quick.Iterations != 60 {
+		t.Errorf("Iterations = %d, want 60", quick.Iterations)
+	}
+	if len(quick.Samples) != 3 || quick.Samples[1] != 110*time.Nanosecond {
+		t.Errorf("Samples = %v, want per-iteration samples", quick.Samples)
+	}
+	if quick.StdDev != 10*time.Nanosecond {
+		t.Errorf("StdDev = %v, want 10ns from samples", quick.StdDev)
+	}
+	wantMetadata := map[string]string{
+		"group": "sort", "function": "quick", "param.value": "1000",
+		"median_ns": "98", "mean_lower_ns": "96.5", "mean_upper_ns": "104.25",
+	}
+	for key, want := range wantMetadata {
+		if got := quick.Metadata[key]; got != want {
+			t.Errorf("Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+	// 4000 bytes per 100ns = 40000 MB/s
+	if quick.Throughput == nil || quick.Throughput.Unit != "MB/s" || quick.Throughput.Value != 40000 {
+		t.Errorf("Throughput = %+v, want 40000 MB/s", quick.Throughput)
+	}
+
+	merge := suite.Results[1]
+	if merge.Throughput == nil || merge.Throughput.Unit != "elem/s" || merge.Throughput.Value != 5e9 {
+		t.Errorf("Throughput = %+v, want 5e9 elem/s", merge.Throughput)
+	}
+
+	fib := suite.Results[2]
+	if fib.Metadata["group"] != "fib 20" || fib.Metadata["function"] != "" {
+		t.Errorf("Metadata = %v, want group 'fib 20' without function", fib.Metadata)
+	}
+	if fib.Throughput != nil {
+		t.Errorf("Throughput = %+v, want nil", fib.Throughput)
+	}
+}
+
+func TestCriterionParser_Parse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "no messages", input: "Benchmarking sort: Warming up for 3.0000 s\n"},
+		{name: "unsupported unit", input: `{"reason":"benchmark-complete","id":"alloc","iteration_count":[1],"measured_values":[10],"unit":"bytes"}`},
+		{name: "no samples", input: `{"reason":"benchmark-complete","id":"sort","iteration_count":[],"measured_values":[],"unit":"ns"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCriterionParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestParseCriterionDirectory(t *testing.T) {
+	root := t.TempDir()
+	writeCriterionFiles(t, filepath.Join(root, "sort", "quick", "1000", "new"), map[string]string{
+		"benchmark.json": `{"group_id":"sort","function_id":"quick","value_str":"1000","throughput":{"Bytes":4000},"full_id":"sort/quick/1000","directory_name":"sort/quick/1000","title":"sort/quick/1000"}`,
+		"estimates.json": `{"mean":{"confidence_interval":{"confidence_level":0.95,"lower_bound":96.5,"upper_bound":104.25},"point_estimate":100.0,"standard_error":2.0},"median":{"confidence_interval":{"confidence_level":0.95,"lower_bound":95.0,"upper_bound":101.0},"point_estimate":98.0,"standard_error":1.5},"median_abs_dev":{"confidence_interval":{"confidence_level":0.95,"lower_bound":2.0,"upper_bound":4.0},"point_estimate":3.0,"standard_error":0.5},"slope":null,"std_dev":{"confidence_interval":{"confidence_level":0.95,"lower_bound":8.0,"upper_bound":12.0},"point_estimate":10.0,"standard_error":1.0}}`,
+		"sample.json":    `{"sampling_mode":"Linear","iters":[10.0,20.0,30.0],"times":[1000.0,2200.0,2700.0]}`,
+	})
+	// Saved baselines and reports are not current results
+	writeCriterionFiles(t, filepath.Join(root, "sort", "quick", "1000", "base"), map[string]string{
+		"benchmark.json": `{"group_id":"sort","function_id":"quick","value_str":"1000","full_id":"sort/quick/1000"}`,
+		"estimates.json": `{"mean":{"point_estimate":500.0}}`,
+	})
+	writeCriterionFiles(t, filepath.Join(root, "fib 20", "new"), map[string]string{
+		"benchmark.json": `{"group_id":"fib 20","function_id":null,"value_str":null,"throughput":null,"full_id":"fib 20"}`,
+		"estimates.json": `{"mean":{"confidence_interval":{"confidence_level":0.99,"lower_bound":49.0,"upper_bound":51.0},"point_estimate":50.0,"standard_error":0.5},"median":{"confidence_interval":{"confidence_level":0.99,"lower_bound":49.0,"upper_bound":51.0},"point_estimate":50.0,"standard_error":0.5},"std_dev":{"confidence_interval":{"confidence_level":0.99,"lower_bound":1.0,"upper_bound":3.0},"point_estimate":2.0,"standard_error":0.2}}`,
+	})
+
+	suite, err := ParseCriterionDirectory(root)
+	if err != nil {
+		t.Fatalf("ParseCriterionDirectory() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+
+	fib, quick := suite.Results[0], suite.Results[1]
+	if fib.Name != "fib 20" || fib.Time != 50*time.Nanosecond || fib.StdDev != 2*time.Nanosecond {
+		t.Errorf("Results[0] = %s %v ± %v, want fib 20 50ns ± 2ns", fib.Name, fib.Time, fib.StdDev)
+	}
+	if fib.Samples != nil || fib.Metadata["confidence_level"] != "0.99" {
+		t.Errorf("Results[0] samples = %v, metadata = %v", fib.Samples, fib.Metadata)
+	}
+
+	if quick.Name != "sort/quick/1000" || quick.Time != 100*time.Nanosecond || quick.StdDev != 10*time.Nanosecond {
+		t.Errorf("Results[1] = %s %v ± %v, want sort/quick/1000 100ns ± 10ns", quick.Name, quick.Time, quick.StdDev)
+	}
+	if len(quick.Samples) != 3 || quick.Iterations != 60 {
+		t.Errorf("Results[1] samples = %v, iterations = %d", quick.Samples, quick.Iterations)
+	}
+	if quick.Metadata["function"] != "quick" || quick.Metadata["param.value"] != "1000" || quick.Metadata["mean_upper_ns"] != "104.25" {
+		t.Errorf("Results[1] metadata = %v", quick.Metadata)
+	}
+	if quick.Throughput == nil || quick.Throughput.Value != 40000 {
+		t.Errorf("Results[1] throughput = %+v, want 40000 MB/s", quick.Throughput)
+	}
+}
+
+func TestParseCriterionDirectory_Errors(t *testing.T) {
+	if _, err := ParseCriterionDirectory(t.TempDir()); err == nil {
+		t.Error("expected error for a directory without results")
+	}
+
+	root := t.TempDir()
+	writeCriterionFiles(t, filepath.Join(root, "sort", "new"), map[string]string{
+		"benchmark.json": `{"group_id":"sort","full_id":"sort"}`,
+	})
+	if _, err := ParseCriterionDirectory(root); err == nil {
+		t.Error("expected error when estimates.json is missing")
+	}
+
+	samples := []struct {
+		name  string
+		input string
+	}{
+		{name: "malformed JSON", input: `{"iters": [1.0`},
+		{name: "length mismatch", input: `{"iters": [1.0, 2.0], "times": [100.0]}`},
+		{name: "empty", input: `{"iters": [], "times": []}`},
+		{name: "zero iterations", input: `{"iters": [0.0], "times": [100.0]}`},
+	}
+	for _, tt := range samples {
+		t.Run("sample.json "+tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeCriterionFiles(t, filepath.Join(root, "sort", "new"), map[string]string{
+				"benchmark.json": `{"group_id":"sort","full_id":"sort"}`,
+				"estimates.json": `{"mean":{"point_estimate":100.0}}`,
+				"sample.json":    tt.input,
+			})
+			if _, err := ParseCriterionDirectory(root); err == nil {
+				t.Error("ParseCriterionDirectory() error = nil, want error for invalid sample.json")
+			}
+		})
+	}
+}
+
+// writeCriterionFiles writes Criterion result files into dir
+func writeCriterionFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", dir, err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+}
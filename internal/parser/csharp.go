@@ -0,0 +1,306 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BenchmarkDotNetParser implements Parser for the BenchmarkDotNet (.NET) JSON exporter
+// (*-report-full-compressed.json, *-report-full.json or *-report-brief.json)
+type BenchmarkDotNetParser struct{}
+
+// NewBenchmarkDotNetParser creates a new BenchmarkDotNet parser
+func NewBenchmarkDotNetParser() *BenchmarkDotNetParser {
+	return &BenchmarkDotNetParser{}
+}
+
+// Language returns the language this parser supports
+func (p *BenchmarkDotNetParser) Language() string {
+	return "csharp"
+}
+
+// dotnetReportJSON represents one BenchmarkDotNet JSON report (one per benchmark class)
+type dotnetReportJSON struct {
+	Title               string                 `json:"Title"`
+	HostEnvironmentInfo *dotnetHostEnvironment `json:"HostEnvironmentInfo"`
+	Benchmarks          []dotnetBenchmark      `json:"Benchmarks"`
+}
+
+// dotnetHostEnvironment describes the machine and runtime the benchmarks ran on
+type dotnetHostEnvironment struct {
+	BenchmarkDotNetVersion string `json:"BenchmarkDotNetVersion"`
+	OsVersion              string `json:"OsVersion"`
+	ProcessorName          string `json:"ProcessorName"`
+	PhysicalProcessorCount int    `json:"PhysicalProcessorCount"`
+	PhysicalCoreCount      int    `json:"PhysicalCoreCount"`
+	LogicalCoreCount       int    `json:"LogicalCoreCount"`
+	RuntimeVersion         string `json:"RuntimeVersion"`
+	Architecture           string `json:"Architecture"`
+	Configuration          string `json:"Configuration"`
+	DotNetCliVersion       string `json:"DotNetCliVersion"`
+}
+
+// dotnetBenchmark is one benchmark case (method, parameters and job)
+type dotnetBenchmark struct {
+	DisplayInfo  string              `json:"DisplayInfo"` // e.g. "Md5VsSha256.Sha256: DefaultJob [N=1000]"
+	Namespace    string              `json:"Namespace"`
+	Type         string              `json:"Type"`
+	Method       string              `json:"Method"`
+	Parameters   string              `json:"Parameters"` // e.g. "N=1000&Size=10"
+	FullName     string              `json:"FullName"`
+	Statistics   *dotnetStatistics   `json:"Statistics"`
+	Memory       *dotnetMemory       `json:"Memory"`
+	Measurements []dotnetMeasurement `json:"Measurements"`
+}
+
+// dotnetStatistics are the workload statistics, in nanoseconds per operation
+type dotnetStatistics struct {
+	OriginalValues     []float64          `json:"OriginalValues"`
+	N                  int64              `json:"N"`
+	Min                float64            `json:"Min"`
+	Q1                 float64            `json:"Q1"`
+	Median             float64            `json:"Median"`
+	Mean               float64            `json:"Mean"`
+	Q3                 float64            `json:"Q3"`
+	Max                float64            `json:"Max"`
+	StandardDeviation  float64            `json:"StandardDeviation"`
+	ConfidenceInterval *dotnetConfidence  `json:"ConfidenceInterval"`
+	Percentiles        map[string]float64 `json:"Percentiles"` // "P0", "P25", "P50", ..., "P100"
+}
+
+// dotnetConfidence is the confidence interval of the mean
+type dotnetConfidence struct {
+	Level int     `json:"Level"` // ConfidenceLevel enum, e.g. 12 for L999
+	Lower float64 `json:"Lower"`
+	Upper float64 `json:"Upper"`
+}
+
+// dotnetMemory holds the MemoryDiagnoser results
+type dotnetMemory struct {
+	Gen0Collections            int64 `json:"Gen0Collections"`
+	Gen1Collections            int64 `json:"Gen1Collections"`
+	Gen2Collections            int64 `json:"Gen2Collections"`
+	TotalOperations            int64 `json:"TotalOperations"`
+	BytesAllocatedPerOperation int64 `json:"BytesAllocatedPerOperation"`
+}
+
+// dotnetMeasurement is one iteration of one stage (pilot, warmup, actual, ...)
+type dotnetMeasurement struct {
+	IterationMode  string `json:"IterationMode"`  // e.g. "Overhead" or "Workload"
+	IterationStage string `json:"IterationStage"` // e.g. "Warmup" or "Actual"
+	Operations     int64  `json:"Operations"`
+}
+
+// dotnetPercentiles are the percentiles kept in metadata
+var dotnetPercentiles = []string{"P0", "P25", "P50", "P67", "P80", "P85", "P90", "P95", "P100"}
+
+// Parse parses BenchmarkDotNet JSON reports.
+// Several reports (one per benchmark class) may follow each other, e.g. from
+// `cat BenchmarkDotNet.Artifacts/results/*-report-full-compressed.json`; a console
+// log before the first report is skipped.
+func (p *BenchmarkDotNetParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	suite := &BenchmarkSuite{
+		Language:  "csharp",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	var benchmarks []dotnetBenchmark
+	decoder := json.NewDecoder(bytes.NewReader(dotnetJSON(output)))
+	for {
+		var report dotnetReportJSON
+		if err := decoder.Decode(&report); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, &ParseError{
+				Message: fmt.Sprintf("failed to parse JSON: %v", err),
+				Input:   string(output),
+			}
+		}
+		if report.HostEnvironmentInfo != nil {
+			report.HostEnvironmentInfo.addMetadata(suite.Metadata)
+		}
+		benchmarks = append(benchmarks, report.Benchmarks...)
+	}
+
+	// The same method runs once per job; qualify names only when they collide
+	jobs := make(map[string]map[string]bool)
+	for _, bench := range benchmarks {
+		name := bench.name()
+		if jobs[name] == nil {
+			jobs[name] = make(map[string]bool)
+		}
+		jobs[name][bench.job()] = true
+	}
+
+	for _, bench := range benchmarks {
+		if bench.Statistics == nil {
+			// Failed benchmarks have no statistics
+			continue
+		}
+		result := bench.result()
+		if len(jobs[result.Name]) > 1 {
+			result.Name += " [" + bench.job() + "]"
+		}
+		suite.Results = append(suite.Results, result)
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// dotnetJSON returns the output from the first line that starts a JSON object
+func dotnetJSON(output []byte) []byte {
+	offset := 0
+	for _, line := range bytes.SplitAfter(output, []byte("\n")) {
+		if bytes.HasPrefix(bytes.TrimSpace(line), []byte("{")) {
+			return output[offset:]
+		}
+		offset += len(line)
+	}
+	return output
+}
+
+// name returns the full name, e.g. "MyBenchmarks.Md5VsSha256.Sha256(N: 1000)"
+func (b *dotnetBenchmark) name() string {
+	if b.FullName != "" {
+		return b.FullName
+	}
+	return strings.TrimPrefix(b.Namespace+"."+b.Type+"."+b.Method, ".")
+}
+
+// job returns the job name from DisplayInfo ("Type.Method: Job [Params]")
+func (b *dotnetBenchmark) job() string {
+	_, job, ok := strings.Cut(b.DisplayInfo, ": ")
+	if !ok {
+		return ""
+	}
+	if idx := strings.Index(job, " ["); idx >= 0 {
+		job = job[:idx]
+	}
+	return strings.TrimSpace(job)
+}
+
+// operationsPerIteration returns the operations of each measured workload iteration,
+// or 0 when the report has no measurements (e.g. a brief report)
+func (b *dotnetBenchmark) operationsPerIteration() int64 {
+	for _, m := range b.Measurements {
+		if m.IterationMode == "Workload" && m.IterationStage == "Actual" {
+			return m.Operations
+		}
+	}
+	return 0
+}
+
+// result converts a benchmark case with statistics into a BenchmarkResult
+func (b *dotnetBenchmark) result() *BenchmarkResult {
+	stats := b.Statistics
+
+	result := &BenchmarkResult{
+		Name:        b.name(),
+		Language:    "csharp",
+		Time:        time.Duration(math.Round(stats.Mean)),
+		Iterations:  b.operationsPerIteration(),
+		SampleCount: stats.N,
+		StdDev:      time.Duration(math.Round(stats.StandardDeviation)),
+		Metadata: map[string]string{
+			"framework":      "benchmarkdotnet",
+			MetadataMedianNs: formatNanos(stats.Median),
+			MetadataMinNs:    formatNanos(stats.Min),
+			MetadataMaxNs:    formatNanos(stats.Max),
+			"q1_ns":          formatNanos(stats.Q1),
+			"q3_ns":          formatNanos(stats.Q3),
+		},
+	}
+
+	for _, ns := range stats.OriginalValues {
+		result.Samples = append(result.Samples, time.Duration(math.Round(ns)))
+	}
+
+	for _, name := range dotnetPercentiles {
+		if value, ok := stats.Percentiles[name]; ok {
+			result.Metadata[strings.ToLower(name)+"_ns"] = formatNanos(value)
+		}
+	}
+	if ci := stats.ConfidenceInterval; ci != nil && (ci.Lower != 0 || ci.Upper != 0) {
+		result.Metadata["mean_lower_ns"] = formatNanos(ci.Lower)
+		result.Metadata["mean_upper_ns"] = formatNanos(ci.Upper)
+	}
+
+	for key, value := range map[string]string{
+		"namespace": b.Namespace,
+		"type":      b.Type,
+		"method":    b.Method,
+		"job":       b.job(),
+	} {
+		if value != "" {
+			result.Metadata[key] = value
+		}
+	}
+	if b.Parameters != "" {
+		result.Metadata["parameters"] = b.Parameters
+		for _, param := range strings.Split(b.Parameters, "&") {
+			if key, value, ok := strings.Cut(param, "="); ok {
+				result.Metadata[MetadataParamPrefix+key] = value
+			}
+		}
+	}
+
+	// MemoryDiagnoser: allocated bytes per operation and GC collections per 1000 operations.
+	// Allocation counts aren't reported, so bytes are a named metric rather than Memory;
+	// the aggregator takes BytesPerOp from it and leaves AllocsPerOp unmeasured.
+	if mem := b.Memory; mem != nil && mem.TotalOperations > 0 {
+		perThousand := func(collections int64) float64 {
+			return float64(collections) / float64(mem.TotalOperations) * 1000
+		}
+		result.Metrics = append(result.Metrics,
+			Metric{Name: MetricBytesPerOp, Unit: "B/op", Value: float64(mem.BytesAllocatedPerOperation), Deterministic: true},
+			Metric{Name: "gen0_collections", Unit: "collections/1k op", Value: perThousand(mem.Gen0Collections)},
+			Metric{Name: "gen1_collections", Unit: "collections/1k op", Value: perThousand(mem.Gen1Collections)},
+			Metric{Name: "gen2_collections", Unit: "collections/1k op", Value: perThousand(mem.Gen2Collections)},
+		)
+	}
+
+	return result
+}
+
+// addMetadata records the host environment as suite metadata
+func (h *dotnetHostEnvironment) addMetadata(metadata map[string]string) {
+	for key, value := range map[string]string{
+		"benchmarkdotnet_version": h.BenchmarkDotNetVersion,
+		"os":                      h.OsVersion,
+		MetadataCPU:               h.ProcessorName,
+		"runtime_version":         h.RuntimeVersion,
+		"architecture":            h.Architecture,
+		"configuration":           h.Configuration,
+		"dotnet_cli_version":      h.DotNetCliVersion,
+	} {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+
+	for key, count := range map[string]int{
+		"physical_processors": h.PhysicalProcessorCount,
+		"physical_cores":      h.PhysicalCoreCount,
+		"logical_cores":       h.LogicalCoreCount,
+	} {
+		if count > 0 {
+			metadata[key] = strconv.Itoa(count)
+		}
+	}
+}
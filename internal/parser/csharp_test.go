@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestBenchmarkDotNetParser_Language(t *testing.T) {
+	if got := NewBenchmarkDotNetParser().Language(); got != "csharp" {
+		t.Errorf("Language() = %v, want csharp", got)
+	}
+}
+
+func TestBenchmarkDotNetParser_FullCompressed(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/csharp/Md5VsSha256-report-full-compressed.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+
+	suite, err := NewBenchmarkDotNetParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	wantSuite := map[string]string{
+		"benchmarkdotnet_version": "0.13.12",
+		"cpu":                     "AMD EPYC 7763",
+		"runtime_version":         ".NET 8.0.1 (8.0.123.58001)",
+		"architecture":            "X64",
+		"logical_cores":           "4",
+		"configuration":           "RELEASE",
+	}
+	for key, want := range wantSuite {
+		if got := suite.Metadata[key]; got != want {
+			t.Errorf("suite.Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	// Md5 has no statistics (it failed) and is skipped
+	if len(suite.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1", len(suite.Results))
+	}
+
+	result := suite.Results[0]
+	if result.Name != "MyBenchmarks.Md5VsSha256.Sha256(N: 1000, Seed: 42)" || result.Language != "csharp" {
+		t.Errorf("Result = %q (%s)", result.Name, result.Language)
+	}
+	if result.Time != 5000*time.Nanosecond || result.StdDev != 82*time.Nanosecond || result.SampleCount != 4 {
+		t.Errorf("Result = %v ± %v over %d, want 5µs ± 82ns over 4", result.Time, result.StdDev, result.SampleCount)
+	}
+	if result.Iterations != 1024 {
+		t.Errorf("Iterations = %d, want 1024 operations per workload iteration", result.Iterations)
+	}
+	if len(result.Samples) != 4 || result.Samples[1] != 5100*time.Nanosecond {
+		t.Errorf("Samples = %v, want OriginalValues", result.Samples)
+	}
+
+	wantMetadata := map[string]string{
+		"framework":     "benchmarkdotnet",
+		"median_ns":     "5000",
+		"min_ns":        "4900",
+		"max_ns":        "5100",
+		"p95_ns":        "5100",
+		"mean_lower_ns": "4998",
+		"mean_upper_ns": "5002",
+		"job":           "DefaultJob",
+		"method":        "Sha256",
+		"parameters":    "N=1000&Seed=42",
+		"param.N":       "1000",
+		"param.Seed":    "42",
+	}
+	for key, want := range wantMetadata {
+		if got := result.Metadata[key]; got != want {
+			t.Errorf("Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	wantMetrics := []Metric{
+		{Name: MetricBytesPerOp, Unit: "B/op", Value: 112},
+		{Name: "gen0_collections", Unit: "collections/1k op", Value: 0.48828125},
+		{Name: "gen1_collections", Unit: "collections/1k op", Value: 0},
+		{Name: "gen2_collections", Unit: "collections/1k op", Value: 0},
+	}
+	if len(result.Metrics) != len(wantMetrics) {
+		t.Fatalf("Metrics = %+v, want %d metrics", result.Metrics, len(wantMetrics))
+	}
+	for i, want := range wantMetrics {
+		if m := result.Metrics[i]; m.Name != want.Name || m.Unit != want.Unit || m.Value != want.Value || m.HigherIsBetter {
+			t.Errorf("Metrics[%d] = %+v, want %+v", i, m, want)
+		}
+	}
+}
+
+func TestBenchmarkDotNetParser_ConcatenatedReportsAndJobs(t *testing.T) {
+	first, err := os.ReadFile("../../testdata/csharp/Md5VsSha256-report-full-compressed.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+	second, err := os.ReadFile("../../testdata/csharp/Parsing-report-full-compressed.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+
+	// Console log from `dotnet run`, then `cat *-report-full-compressed.json`
+	input := []byte("// * Summary *\nBenchmarkDotNet v0.13.12, Ubuntu 22.04.3 LTS\n")
+	input = append(input, first...)
+	input = append(input, second...)
+
+	suite, err := NewBenchmarkDotNetParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(suite.Results))
+	}
+
+	// The same method under two runtime jobs is qualified by job
+	net6, net8 := suite.Results[1], suite.Results[2]
+	if net6.Name != "MyBenchmarks.Parsing.ParseInt [.NET 6.0]" || net8.Name != "MyBenchmarks.Parsing.ParseInt [.NET 8.0]" {
+		t.Errorf("Names = %q, %q, want job-qualified names", net6.Name, net8.Name)
+	}
+	if net6.Time != 21*time.Nanosecond || net8.Time != 11*time.Nanosecond {
+		t.Errorf("Times = %v, %v, want 21ns and 11ns", net6.Time, net8.Time)
+	}
+	if net8.Metadata["job"] != ".NET 8.0" {
+		t.Errorf("Metadata['job'] = %q, want .NET 8.0", net8.Metadata["job"])
+	}
+	if net8.Metrics != nil {
+		t.Errorf("Metrics = %+v, want none without MemoryDiagnoser", net8.Metrics)
+	}
+}
+
+func TestBenchmarkDotNetParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "invalid JSON", input: "{not json"},
+		{name: "no benchmarks", input: `{"Title": "x", "Benchmarks": []}`},
+		{name: "no statistics", input: `{"Title": "x", "Benchmarks": [{"FullName": "A.B", "Statistics": null}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewBenchmarkDotNetParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
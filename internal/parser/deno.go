@@ -0,0 +1,199 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DenoParser implements Parser for `deno bench --json` output
+type DenoParser struct{}
+
+// NewDenoParser creates a new Deno bench parser
+func NewDenoParser() *DenoParser {
+	return &DenoParser{}
+}
+
+// Language returns the language this parser supports
+func (p *DenoParser) Language() string {
+	return "deno"
+}
+
+// denoBenchJSON represents the structure of `deno bench --json` output
+type denoBenchJSON struct {
+	Version int             `json:"version"`
+	Runtime string          `json:"runtime"` // e.g. "Deno/1.40.2 x86_64-unknown-linux-gnu"
+	CPU     string          `json:"cpu"`
+	Benches []denoBenchmark `json:"benches"`
+}
+
+// denoBenchmark is one Deno.bench() registration
+type denoBenchmark struct {
+	Origin   string            `json:"origin"` // Module URL, e.g. "file:///project/bench/sort_bench.ts"
+	Group    *string           `json:"group"`
+	Name     string            `json:"name"`
+	Baseline bool              `json:"baseline"`
+	Results  []denoBenchResult `json:"results"`
+}
+
+// denoBenchResult holds either the measurement or the failure of a benchmark
+type denoBenchResult struct {
+	Ok     *denoBenchStats `json:"ok"`
+	Failed json.RawMessage `json:"failed"`
+}
+
+// denoBenchStats are the benchmark statistics, in nanoseconds per iteration
+type denoBenchStats struct {
+	N                  int64   `json:"n"`
+	Min                float64 `json:"min"`
+	Max                float64 `json:"max"`
+	Avg                float64 `json:"avg"`
+	P75                float64 `json:"p75"`
+	P99                float64 `json:"p99"`
+	P995               float64 `json:"p995"`
+	P999               float64 `json:"p999"`
+	HighPrecision      bool    `json:"highPrecision"`
+	UsedExplicitTimers bool    `json:"usedExplicitTimers"`
+}
+
+// Parse parses `deno bench --json` output.
+// Results are named after their module (relative to the directory shared by all
+// modules), group and name, e.g. "bench/sort_bench.ts > sorting > Array.sort".
+// Failed benchmarks are skipped.
+func (p *DenoParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data denoBenchJSON
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "deno",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+	if data.Runtime != "" {
+		suite.Metadata["runtime"] = data.Runtime
+	}
+	if data.CPU != "" {
+		suite.Metadata[MetadataCPU] = data.CPU
+	}
+
+	origins := make([]string, 0, len(data.Benches))
+	for _, bench := range data.Benches {
+		origins = append(origins, denoModulePath(bench.Origin))
+	}
+	root := commonDir(origins)
+
+	for i, bench := range data.Benches {
+		for _, run := range bench.Results {
+			if run.Ok == nil {
+				continue
+			}
+			if run.Ok.Avg < 0 {
+				return nil, &ParseError{
+					Line:    i + 1,
+					Message: fmt.Sprintf("invalid average time: %f", run.Ok.Avg),
+					Input:   bench.Name,
+				}
+			}
+
+			module := strings.TrimPrefix(origins[i], root)
+			suite.Results = append(suite.Results, bench.result(module, run.Ok))
+			break
+		}
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// result converts the statistics of a benchmark into a BenchmarkResult
+func (b *denoBenchmark) result(module string, stats *denoBenchStats) *BenchmarkResult {
+	parts := []string{module}
+	if b.Group != nil && *b.Group != "" {
+		parts = append(parts, *b.Group)
+	}
+	parts = append(parts, b.Name)
+
+	result := &BenchmarkResult{
+		Name:       strings.Join(parts, " > "),
+		Language:   "deno",
+		Time:       time.Duration(math.Round(stats.Avg)),
+		Iterations: stats.N,
+		Metadata: map[string]string{
+			"framework":      "deno",
+			"module":         module,
+			"high_precision": strconv.FormatBool(stats.HighPrecision),
+		},
+	}
+	if stats.Avg > 0 {
+		result.Throughput = &Throughput{Value: 1e9 / stats.Avg, Unit: "ops/s"}
+	}
+	if b.Group != nil && *b.Group != "" {
+		result.Metadata[MetadataGroup] = *b.Group
+	}
+	if b.Baseline {
+		result.Metadata["baseline"] = "true"
+	}
+
+	for key, ns := range map[string]float64{
+		MetadataMinNs: stats.Min,
+		MetadataMaxNs: stats.Max,
+		"p75_ns":      stats.P75,
+		"p99_ns":      stats.P99,
+		"p995_ns":     stats.P995,
+		"p999_ns":     stats.P999,
+	} {
+		if ns > 0 {
+			result.Metadata[key] = formatNanos(ns)
+		}
+	}
+
+	return result
+}
+
+// denoModulePath returns the path of a module URL ("file:///a/b.ts" -> "/a/b.ts"),
+// or the URL itself for remote modules
+func denoModulePath(origin string) string {
+	if rest, ok := strings.CutPrefix(origin, "file://"); ok {
+		return rest
+	}
+	return origin
+}
+
+// commonDir returns the longest directory prefix, with a trailing slash, shared by all paths
+func commonDir(paths []string) string {
+	if len(paths) == 0 {
+		return ""
+	}
+
+	prefix := path.Dir(paths[0])
+	if prefix == "." {
+		return ""
+	}
+	prefix = strings.TrimSuffix(prefix, "/") + "/"
+	for _, p := range paths[1:] {
+		for !strings.HasPrefix(p, prefix) {
+			trimmed := strings.TrimSuffix(prefix, "/")
+			idx := strings.LastIndex(trimmed, "/")
+			if idx < 0 {
+				return ""
+			}
+			prefix = trimmed[:idx+1]
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDenoParser_Language(t *testing.T) {
+	if got := NewDenoParser().Language(); got != "deno" {
+		t.Errorf("Language() = %v, want deno", got)
+	}
+}
+
+func TestDenoParser_Parse(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/deno/deno_bench.json")
+	if err != nil {
+		t.Fatalf("failed to read testdata: %v", err)
+	}
+
+	suite, err := NewDenoParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	// The failed benchmark is skipped
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+	if suite.Metadata["runtime"] != "Deno/1.40.2 x86_64-unknown-linux-gnu" || suite.Metadata[MetadataCPU] != "AMD EPYC 7763 64-Core Processor" {
+		t.Errorf("Suite.Metadata = %v", suite.Metadata)
+	}
+
+	sort := suite.Results[0]
+	if sort.Name != "sort_bench.ts > sorting > Array.prototype.sort" {
+		t.Errorf("Results[0].Name = %q", sort.Name)
+	}
+	if sort.Language != "deno" || sort.Time != 9040*time.Nanosecond || sort.Iterations != 51234 {
+		t.Errorf("Results[0] = %s %v over %d", sort.Language, sort.Time, sort.Iterations)
+	}
+	if sort.Throughput == nil || sort.Throughput.Unit != "ops/s" || sort.Throughput.Value < 110616 || sort.Throughput.Value > 110617 {
+		t.Errorf("Results[0].Throughput = %+v, want ~110616.3 ops/s", sort.Throughput)
+	}
+	for key, want := range map[string]string{
+		"framework":   "deno",
+		"module":      "sort_bench.ts",
+		MetadataGroup: "sorting",
+		"baseline":    "true",
+		MetadataMinNs: "8120.5",
+		MetadataMaxNs: "35010.2",
+		"p75_ns":      "8900.1",
+		"p99_ns":      "15020.8",
+		"p995_ns":     "20001.3",
+		"p999_ns":     "30002.7",
+	} {
+		if got := sort.Metadata[key]; got != want {
+			t.Errorf("Results[0].Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	parse := suite.Results[1]
+	if parse.Name != "parse/json_bench.ts > JSON.parse" {
+		t.Errorf("Results[1].Name = %q", parse.Name)
+	}
+	if _, ok := parse.Metadata[MetadataGroup]; ok {
+		t.Errorf("Results[1].Metadata[%q] is set, want unset", MetadataGroup)
+	}
+}
+
+func TestDenoParser_Parse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"invalid JSON", `{"benches": [`},
+		{"no benchmarks", `{"version": 1, "benches": []}`},
+		{"only failures", `{"benches": [{"origin": "file:///a_bench.ts", "name": "x", "results": [{"failed": "boom"}]}]}`},
+		{"negative average", `{"benches": [{"origin": "file:///a_bench.ts", "name": "x", "results": [{"ok": {"n": 1, "avg": -1}}]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewDenoParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestCommonDir(t *testing.T) {
+	tests := []struct {
+		paths []string
+		want  string
+	}{
+		{[]string{"/p/bench/a.ts"}, "/p/bench/"},
+		{[]string{"/p/bench/a.ts", "/p/bench/x/b.ts"}, "/p/bench/"},
+		{[]string{"/p/bench/a.ts", "/p/other/b.ts"}, "/p/"},
+		{[]string{"/a.ts", "/b.ts"}, "/"},
+		{[]string{"a.ts"}, ""},
+		{[]string{"https://deno.land/x/a.ts", "/p/b.ts"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := commonDir(tt.paths); got != tt.want {
+			t.Errorf("commonDir(%v) = %q, want %q", tt.paths, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DivanParser implements Parser for the tree table printed by divan (`cargo bench` with divan)
+type DivanParser struct{}
+
+// NewDivanParser creates a new divan benchmark parser
+func NewDivanParser() *DivanParser {
+	return &DivanParser{}
+}
+
+// Language returns the language this parser supports
+func (p *DivanParser) Language() string {
+	return "rust"
+}
+
+// divanValueRegex matches a trailing "value unit" cell, e.g. "35.93 ns" or "34.01 GB/s"
+var divanValueRegex = regexp.MustCompile(`^(.*?)\s*(\d+(?:\.\d+)?)\s+(\S+)$`)
+
+// divanTimeUnits converts divan's time units to nanoseconds
+var divanTimeUnits = map[string]float64{
+	"ps": 1e-3,
+	"ns": 1,
+	"µs": 1e3,
+	"μs": 1e3,
+	"us": 1e3,
+	"ms": 1e6,
+	"s":  1e9,
+}
+
+// divanThroughputUnits converts divan's counter units to MB/s (bytes) or elem/s (items)
+var divanThroughputUnits = map[string]struct {
+	unit   string
+	factor float64
+}{
+	"B/s":      {"MB/s", 1e-6},
+	"KB/s":     {"MB/s", 1e-3},
+	"MB/s":     {"MB/s", 1},
+	"GB/s":     {"MB/s", 1e3},
+	"TB/s":     {"MB/s", 1e6},
+	"KiB/s":    {"MB/s", 1024 / 1e6},
+	"MiB/s":    {"MB/s", 1024 * 1024 / 1e6},
+	"GiB/s":    {"MB/s", 1024 * 1024 * 1024 / 1e6},
+	"TiB/s":    {"MB/s", 1024 * 1024 * 1024 * 1024 / 1e6},
+	"item/s":   {"elem/s", 1},
+	"Kitem/s":  {"elem/s", 1e3},
+	"Mitem/s":  {"elem/s", 1e6},
+	"Gitem/s":  {"elem/s", 1e9},
+	"Titem/s":  {"elem/s", 1e12},
+	"char/s":   {"elem/s", 1},
+	"Kchar/s":  {"elem/s", 1e3},
+	"Mchar/s":  {"elem/s", 1e6},
+	"Gchar/s":  {"elem/s", 1e9},
+	"Tchar/s":  {"elem/s", 1e12},
+	"ops/s":    {"ops/s", 1},
+	"Kops/s":   {"ops/s", 1e3},
+	"Mops/s":   {"ops/s", 1e6},
+	"Gops/s":   {"ops/s", 1e9},
+	"cycles/s": {"cycles/s", 1},
+}
+
+// Parse parses divan output.
+// Expected format (one table per bench target):
+//
+//	example        fastest       │ slowest       │ median        │ mean          │ samples │ iters
+//	├─ add         0.208 ns      │ 0.297 ns      │ 0.209 ns      │ 0.21 ns       │ 100     │ 409600
+//	╰─ sort                      │               │               │               │         │
+//	   ├─ 10       35.93 ns      │ 43.27 ns      │ 36.29 ns      │ 36.66 ns      │ 100     │ 12800
+//	   │           278.3 Mitem/s │ 231.1 Mitem/s │ 275.5 Mitem/s │ 272.7 Mitem/s │         │
+//	   ╰─ 100      1.07 µs       │ 1.466 µs      │ 1.083 µs      │ 1.093 µs      │ 100     │ 1600
+//
+// Rows are named by their path in the tree, starting at the bench target
+// ("example/sort/10"). Time is the mean; fastest, slowest and median are kept in metadata.
+// A counter row under a benchmark sets its throughput from the mean column.
+func (p *DivanParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	suite := &BenchmarkSuite{
+		Language:  "rust",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	lineNum := 0
+
+	var path []string         // names of the rows above the current one, by depth
+	var last *BenchmarkResult // most recent benchmark row, for counter rows
+	inTable := false
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimRight(scanner.Text(), " ")
+
+		// Header: "<target>  fastest │ slowest │ median │ mean │ samples │ iters"
+		if strings.Contains(line, "fastest") && strings.Contains(line, "│ mean") {
+			fields := strings.Fields(line)
+			path = []string{fields[0]}
+			last = nil
+			inTable = true
+			continue
+		}
+		if !inTable {
+			continue
+		}
+
+		depth, rest, isRow := splitDivanTreePrefix(line)
+		if !isRow {
+			// Counter rows continue the tree without a branch marker
+			if last != nil && strings.Contains(line, "│") {
+				applyDivanCounter(last, line)
+				continue
+			}
+			// Anything else ends the table
+			inTable = false
+			last = nil
+			continue
+		}
+
+		cells := strings.Split(rest, "│")
+		name := strings.TrimSpace(cells[0])
+		fastest, hasValue := "", false
+		if m := divanValueRegex.FindStringSubmatch(name); m != nil {
+			if _, ok := divanTimeUnits[m[3]]; ok && len(cells) >= 6 {
+				name, fastest, hasValue = strings.TrimSpace(m[1]), m[2]+" "+m[3], true
+			}
+		}
+
+		// Keep the path to this row: target, then one entry per tree level
+		if depth+1 < len(path) {
+			path = path[:depth+1]
+		}
+		path = append(path, name)
+
+		if !hasValue {
+			// Group row without measurements
+			last = nil
+			continue
+		}
+
+		result, err := divanResult(path, fastest, cells[1:])
+		if err != nil {
+			return nil, &ParseError{
+				Line:    lineNum,
+				Message: err.Error(),
+				Input:   line,
+			}
+		}
+		suite.Results = append(suite.Results, result)
+		last = result
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %w", err)
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// splitDivanTreePrefix strips the tree drawing before a row's "├─ " or "╰─ " marker
+// and returns the row's depth (0 for rows directly under the target)
+func splitDivanTreePrefix(line string) (int, string, bool) {
+	for _, marker := range []string{"├─ ", "╰─ "} {
+		if i := strings.Index(line, marker); i >= 0 {
+			prefix := line[:i]
+			// Every level above the row is drawn as "│  " or "   "
+			if strings.Trim(prefix, "│ ") != "" {
+				return 0, "", false
+			}
+			depth := len([]rune(prefix)) / 3
+			return depth, line[i+len(marker):], true
+		}
+	}
+	return 0, "", false
+}
+
+// divanResult builds a result from a row's cells: slowest, median, mean, samples, iters
+func divanResult(path []string, fastest string, cells []string) (*BenchmarkResult, error) {
+	values := make([]float64, 0, 4)
+	for _, cell := range append([]string{fastest}, cells[:3]...) {
+		ns, err := parseDivanDuration(strings.TrimSpace(cell))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, ns)
+	}
+	fastestNs, slowestNs, medianNs, meanNs := values[0], values[1], values[2], values[3]
+
+	samples, err := strconv.ParseInt(strings.TrimSpace(cells[3]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse samples: %v", err)
+	}
+	iters, err := strconv.ParseInt(strings.TrimSpace(cells[4]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse iters: %v", err)
+	}
+
+	result := &BenchmarkResult{
+		Name:        strings.Join(path, "/"),
+		Language:    "rust",
+		Time:        time.Duration(math.Round(meanNs)),
+		Iterations:  iters,
+		SampleCount: samples,
+		Metadata: map[string]string{
+			"framework":      "divan",
+			MetadataMinNs:    formatNanos(fastestNs),
+			MetadataMaxNs:    formatNanos(slowestNs),
+			MetadataMedianNs: formatNanos(medianNs),
+			"samples":        fmt.Sprintf("%d", samples),
+		},
+	}
+	if len(path) > 1 {
+		result.Metadata[MetadataGroup] = path[0]
+	}
+
+	return result, nil
+}
+
+// applyDivanCounter sets the result's throughput from a counter row's mean column
+func applyDivanCounter(result *BenchmarkResult, line string) {
+	var values []string
+	for _, cell := range strings.Split(line, "│") {
+		if cell = strings.TrimSpace(cell); cell != "" {
+			values = append(values, cell)
+		}
+	}
+	if len(values) < 4 {
+		return
+	}
+
+	// fastest, slowest, median, mean
+	fields := strings.Fields(values[3])
+	if len(fields) != 2 {
+		return
+	}
+	conv, ok := divanThroughputUnits[fields[1]]
+	if !ok {
+		return
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return
+	}
+
+	// Keep the first counter; divan lists bytes before items
+	if result.Throughput == nil {
+		result.Throughput = &Throughput{Value: value * conv.factor, Unit: conv.unit}
+	}
+}
+
+// parseDivanDuration converts "1.07 µs" to nanoseconds
+func parseDivanDuration(s string) (float64, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("invalid time value: %q", s)
+	}
+	factor, ok := divanTimeUnits[fields[1]]
+	if !ok {
+		return 0, fmt.Errorf("unknown time unit: %q", fields[1])
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time value: %q", s)
+	}
+	return value * factor, nil
+}
@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDivanParser_Language(t *testing.T) {
+	if got := NewDivanParser().Language(); got != "rust" {
+		t.Errorf("Language() = %v, want rust", got)
+	}
+}
+
+func TestDivanParser_Parse(t *testing.T) {
+	input := []byte(`     Running benches/example.rs (target/release/deps/example-1a2b3c)
+Timer precision: 41 ns
+example        fastest       │ slowest       │ median        │ mean          │ samples │ iters
+├─ add         0.208 ns      │ 0.297 ns      │ 0.209 ns      │ 0.21 ns       │ 100     │ 409600
+├─ sort                      │               │               │               │         │
+│  ├─ 10       35.93 ns      │ 43.27 ns      │ 36.29 ns      │ 36.66 ns      │ 100     │ 12800
+│  │           278.3 Mitem/s │ 231.1 Mitem/s │ 275.5 Mitem/s │ 272.7 Mitem/s │         │
+│  ╰─ 100      1.07 µs       │ 1.466 µs      │ 1.083 µs      │ 1.093 µs      │ 100     │ 1600
+╰─ copy        12.5 µs       │ 15.1 µs       │ 12.9 µs       │ 13 µs         │ 100     │ 100
+               80 GB/s       │ 66.2 GB/s     │ 77.5 GB/s     │ 76.92 GB/s    │         │
+
+     Running benches/other.rs (target/release/deps/other-4d5e6f)
+Timer precision: 41 ns
+other          fastest       │ slowest       │ median        │ mean          │ samples │ iters
+╰─ parse       2.5 ms        │ 3 ms          │ 2.6 ms        │ 2.7 ms        │ 50      │ 50
+`)
+
+	suite, err := NewDivanParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name       string
+		time       time.Duration
+		iterations int64
+		median     string
+	}{
+		{"example/add", 0, 409600, "0.209"},
+		{"example/sort/10", 37 * time.Nanosecond, 12800, "36.29"},
+		{"example/sort/100", 1093 * time.Nanosecond, 1600, "1083"},
+		{"example/copy", 13 * time.Microsecond, 100, "12900"},
+		{"other/parse", 2700 * time.Microsecond, 50, "2600000"},
+	}
+
+	if len(suite.Results) != len(tests) {
+		t.Fatalf("len(Results) = %d, want %d", len(suite.Results), len(tests))
+	}
+	for i, tt := range tests {
+		result := suite.Results[i]
+		if result.Name != tt.name {
+			t.Errorf("Results[%d].Name = %q, want %q", i, result.Name, tt.name)
+		}
+		if result.Time != tt.time {
+			t.Errorf("Results[%d].Time = %v, want %v", i, result.Time, tt.time)
+		}
+		if result.Iterations != tt.iterations {
+			t.Errorf("Results[%d].Iterations = %d, want %d", i, result.Iterations, tt.iterations)
+		}
+		if result.Metadata["median_ns"] != tt.median {
+			t.Errorf("Results[%d].Metadata['median_ns'] = %q, want %q", i, result.Metadata["median_ns"], tt.median)
+		}
+	}
+
+	sort10 := suite.Results[1]
+	if sort10.Metadata[MetadataMinNs] != "35.93" || sort10.Metadata[MetadataMaxNs] != "43.27" || sort10.Metadata["samples"] != "100" {
+		t.Errorf("Results[1].Metadata = %v", sort10.Metadata)
+	}
+	if sort10.Throughput == nil || sort10.Throughput.Unit != "elem/s" || math.Abs(sort10.Throughput.Value-272.7e6) > 1 {
+		t.Errorf("Results[1].Throughput = %+v, want 272.7e6 elem/s", sort10.Throughput)
+	}
+	if copyResult := suite.Results[3]; copyResult.Throughput == nil || copyResult.Throughput.Unit != "MB/s" || math.Abs(copyResult.Throughput.Value-76920) > 1e-6 {
+		t.Errorf("Results[3].Throughput = %+v, want 76920 MB/s", copyResult.Throughput)
+	}
+	if suite.Results[0].Throughput != nil || suite.Results[2].Throughput != nil {
+		t.Error("expected no throughput without counter rows")
+	}
+}
+
+func TestDivanParser_Parse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "no table", input: "Timer precision: 41 ns\n"},
+		{name: "unknown time unit", input: `example        fastest       │ slowest       │ median        │ mean          │ samples │ iters
+╰─ add         0.208 ns      │ 0.297 ns      │ 0.209 ns      │ 0.21 years    │ 100     │ 409600`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewDivanParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
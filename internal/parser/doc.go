@@ -10,12 +10,19 @@
 // Currently supported benchmark formats:
 //
 //   - Rust: cargo bench bencher format
+//   - Rust: Criterion.rs (cargo-criterion JSON messages or target/criterion)
+//   - Rust: Divan (cargo bench tree output)
+//   - Rust: iai-callgrind (Callgrind event counts)
 //   - Python: pytest-benchmark JSON
+//   - Python: pyperf JSON
+//   - Python: airspeed velocity (asv) result files
 //   - Go: testing.B output
-//
-// Planned support:
-//
-//   - Rust: criterion format
+//   - C/C++: Google Benchmark JSON
+//   - Java: JMH JSON results
+//   - C#: BenchmarkDotNet JSON exporter
+//   - JavaScript: vitest bench JSON (tinybench), mitata JSON
+//   - Deno: deno bench JSON
+//   - Command line: hyperfine JSON export
 //
 // # Usage
 //
@@ -63,9 +70,20 @@
 //   - Time: average execution time per iteration
 //   - Iterations: number of iterations run
 //   - StdDev: standard deviation of measurements
+//   - SampleCount: number of independent measurements (rounds, repetitions)
+//     behind Time and StdDev, used as the sample size when Samples are absent
 //   - Throughput: optional throughput metrics (bytes/sec, ops/sec)
+//   - Memory: optional bytes and allocations per operation
+//   - Metrics: optional additional named metrics with a unit and better
+//     direction (instructions, cache misses, RSS, custom values); Deterministic
+//     marks counts without measurement noise
+//   - Samples: optional raw per-run samples when the framework reports them
 //   - Metadata: additional key-value data
 //
+// AllMetrics returns all of a result's measurements as one list of named
+// metrics (time_per_op, bytes_per_op, allocs_per_op, throughput, then the
+// additional metrics), so consumers can handle them generically.
+//
 // BenchmarkSuite represents a collection of benchmark results with:
 //   - Results: slice of BenchmarkResult
 //   - Language: source language for all results
@@ -90,10 +108,12 @@
 // Expected format:
 //
 //	test bench_name ... bench:   1,234 ns/iter (+/- 56)
+//	test bench_copy ... bench:   1,234 ns/iter (+/- 56) = 830 MB/s
 //
 // Features:
 //   - Handles comma-separated numbers (1,234)
 //   - Extracts benchmark name, time, and standard deviation
+//   - Records the MB/s suffix (printed when b.bytes is set) as Throughput
 //   - Skips failed and ignored tests
 //   - Tolerates compiler warnings and other output
 //   - Parses zero nanosecond results
@@ -136,8 +156,14 @@
 //   - Converts times from seconds to nanoseconds
 //   - Extracts benchmark name, mean time, standard deviation, iterations (rounds)
 //   - Captures throughput metrics (ops per second)
-//   - Stores quartile data and IQR in metadata
-//   - Handles suite-level metadata (datetime, version)
+//   - Stores quartile data and IQR in metadata, and min/max/median/q1/q3/iqr in
+//     nanoseconds (min_ns, max_ns, median_ns, ...) for the aggregator
+//   - Keeps outlier counts (outliers, stddev_outliers, iqr_outliers) and ops
+//   - Labels results with their group ("group") and parametrize arguments
+//     ("param.size"); extra_info entries become "extra.<key>"
+//   - Handles suite-level metadata (datetime, version) and machine_info, with
+//     nested objects flattened ("cpu.brand_raw") and the CPU brand kept as "cpu"
+//   - Keeps per-round timings as raw samples when saved with --benchmark-save-data
 //
 // Edge cases handled:
 //   - Zero-time benchmarks: mean: 0.0
@@ -146,6 +172,265 @@
 //   - Partial stats: skipped if key metrics missing
 //   - Zero throughput: skipped if ops not present
 //
+// # pyperf Parser Specifics
+//
+// PyperfParser reads pyperf result files (values in seconds unless "unit" says otherwise):
+//
+//	{"version": "1.0", "metadata": {"cpu_model_name": "...", "python_version": "3.12.2 (64-bit)", "loops": 1024, ...},
+//	 "benchmarks": [{"metadata": {"name": "json_dumps"},
+//	   "runs": [{"warmups": [[1, 0.00009], [2, 0.0000875]]},
+//	            {"warmups": [[1024, 0.00005]], "values": [0.000010, 0.000012, 0.000011]}]}]}
+//
+// Features:
+//   - Each benchmark becomes a result; the values of all runs are the samples and
+//     warmups are excluded (only counted in "warmups")
+//   - Time is the mean of the samples, with the median in metadata
+//   - Benchmark metadata overrides the common metadata; "loops", "inner_loops"
+//     and "description" are kept per result
+//   - Results with unit "byte" (--track-memory, --tracemalloc) become a "memory"
+//     metric and "integer" results a "value" metric
+//   - The common metadata is kept as suite metadata, with cpu_model_name as "cpu"
+//
+// # asv Parser Specifics
+//
+// AsvParser reads airspeed velocity result files (format version 2), where each
+// benchmark is a list of the columns named in result_columns:
+//
+//	{"commit_hash": "4b1d2e3f", "env_name": "virtualenv-py3.11-numpy", "params": {"cpu": "...", "machine": "lab-01", ...},
+//	 "result_columns": ["result", "params", "version", "started_at", "duration", "stats_ci_99_a", ...],
+//	 "results": {"benchmarks.SortSuite.time_sort": [[0.001, 0.002, null, NaN], [["100", "1000"], ["'quick'", "'merge'"]], ...]},
+//	 "version": 2}
+//
+// Features:
+//   - Parameterized benchmarks are expanded into one result per combination,
+//     named "time_sort(100, 'quick')" with "group" and "params" in metadata; the
+//     values are also labelled by position ("param.0", "param.1", ...), as asv
+//     keeps parameter names in benchmarks.json
+//   - Failed (null) and skipped (NaN) combinations are left out
+//   - time_ benchmarks: Time is asv's result (the median); samples recorded with
+//     --record-samples are kept, with the 99% confidence interval and quartiles in metadata
+//   - mem_ and peakmem_ benchmarks become the "memory" and "peak_memory" metrics (B),
+//     track_ benchmarks a "value" metric
+//   - Machine parameters (arch, cpu, machine, num_cpu, os, ram), commit, environment,
+//     Python version and requirements are kept as suite metadata
+//
+// # Criterion Parser Specifics
+//
+// CriterionParser reads the JSON messages of `cargo criterion --message-format=json`:
+//
+//	{"reason":"benchmark-complete","id":"sort/quick/1000","iteration_count":[10,20],"measured_values":[1000.0,2200.0],"unit":"ns","throughput":[{"per_iteration":4000,"unit":"bytes"}],"mean":{"estimate":105.0,"lower_bound":100.0,"upper_bound":110.0,"unit":"ns"},...}
+//	{"reason":"group-complete","group_name":"sort","benchmark_ids":["sort/quick/1000"],...}
+//
+// ParseCriterionDirectory reads the files `cargo bench` saves for each benchmark
+// under target/criterion/<id>/new: benchmark.json, estimates.json and sample.json.
+// Saved baselines (base/, named baselines) and reports are ignored.
+//
+// Features:
+//   - Time is the mean point estimate; the median and the mean's confidence
+//     interval are kept in metadata ("median_ns", "mean_lower_ns",
+//     "mean_upper_ns", "confidence_level" when reported)
+//   - StdDev is Criterion's std_dev estimate, or the sample standard deviation
+//     of the per-iteration samples for cargo-criterion messages
+//   - Samples are measured time divided by iteration count for each sample
+//   - Throughput declared with BenchmarkGroup::throughput becomes MB/s (bytes)
+//     or elem/s (elements), derived from the mean time
+//   - The id's group and function are kept in metadata ("group", "function");
+//     its parameter is labelled "param.value"
+//   - Only wall-clock time (unit "ns") is supported
+//
+// # Divan Parser Specifics
+//
+// DivanParser reads the tree table Divan prints for each bench target:
+//
+//	example        fastest       │ slowest       │ median        │ mean          │ samples │ iters
+//	├─ add         0.208 ns      │ 0.297 ns      │ 0.209 ns      │ 0.21 ns       │ 100     │ 409600
+//	╰─ sort                      │               │               │               │         │
+//	   ╰─ 100      1.07 µs       │ 1.466 µs      │ 1.083 µs      │ 1.093 µs      │ 100     │ 1600
+//	               93.51 Mitem/s │ 68.21 Mitem/s │ 92.33 Mitem/s │ 91.49 Mitem/s │         │
+//
+// Features:
+//   - Names join the target, groups and arguments with "/" (e.g., "example/sort/100")
+//   - Time is the mean; fastest, slowest and median are kept in metadata
+//     as "min_ns", "max_ns" and "median_ns", with the sample count ("samples")
+//   - Iterations is the iters column and SampleCount the samples column
+//   - The mean of the first counter row becomes throughput: bytes as MB/s,
+//     items and chars as elem/s
+//
+// # iai-callgrind Parser Specifics
+//
+// IaiCallgrindParser reads the event counts iai-callgrind prints per benchmark:
+//
+//	lib_bench::bench_group::bench_fib short:10
+//	  Instructions:                1734|1730            (+0.23121%) [+1.00231x]
+//	  L1 Hits:                     2359|2359            (No change)
+//	  LL Hits:                        0|0               (No change)
+//	  RAM Hits:                       3|3               (No change)
+//	  Total read+write:            2362|2362            (No change)
+//	  Estimated Cycles:            2464|2464            (No change)
+//
+// Features:
+//   - Every event becomes a lower-is-better named metric ("instructions",
+//     "l1_hits", "ll_hits", "ram_hits", "total_read_write", "estimated_cycles",
+//     and the "*_accesses" events of older releases)
+//   - Only the new value is read; the value after "|" is iai-callgrind's own baseline
+//   - Time is zero since Callgrind does not measure wall-clock time; compare the
+//     metrics instead. The metrics are deterministic, so the comparator gates
+//     them at 1.001 by default
+//   - The function path and benchmark id are kept in metadata ("function", "id")
+//
+// # Google Benchmark Parser Specifics
+//
+// GoogleBenchmarkParser reads the JSON written with --benchmark_format=json
+// or --benchmark_out:
+//
+//	{"context": {"num_cpus": 8, "mhz_per_cpu": 3600, "caches": [...], ...},
+//	 "benchmarks": [
+//	   {"name": "BM_Sort/1024", "run_name": "BM_Sort/1024", "run_type": "iteration", "repetition_index": 0,
+//	    "iterations": 10000, "real_time": 10.0, "cpu_time": 9.5, "time_unit": "us", "bytes_per_second": 409600000},
+//	   {"name": "BM_Sort/1024_mean", "run_name": "BM_Sort/1024", "run_type": "aggregate", "aggregate_name": "mean", ...}
+//	 ]}
+//
+// Features:
+//   - Runs are grouped by run_name; repetitions become samples
+//   - SampleCount is the repetition count and Iterations the iterations per
+//     repetition, with the sum kept as "total_iterations" in metadata
+//   - Time is the mean real time, converted from time_unit (ns, us, ms, s)
+//   - The mean, median, stddev and cv aggregates are used when reported
+//     ("median_ns" and "cv" in metadata); with --benchmark_report_aggregates_only
+//     the mean aggregate provides the values
+//   - CPU time becomes the "cpu_time" metric (ns/op)
+//   - bytes_per_second becomes MB/s throughput, items_per_second elem/s
+//     (or an "items_per_second" metric in elem/s when bytes are also set)
+//   - User counters become metrics named after the counter; names ending in
+//     "/s" or "_per_second" are higher-is-better
+//   - Runs with error_occurred are skipped; threads and label are kept in metadata
+//   - The context (date, host_name, num_cpus, mhz_per_cpu, cpu_scaling_enabled,
+//     library_build_type and caches) is kept as suite metadata
+//
+// # JMH Parser Specifics
+//
+// JMHParser reads the JSON results JMH writes with `-rf json`:
+//
+//	[{"benchmark": "com.example.SortBenchmark.quickSort", "mode": "avgt", "forks": 2,
+//	  "params": {"size": "1000"},
+//	  "primaryMetric": {"score": 12.5, "scoreError": 0.8, "scoreConfidence": [11.7, 13.3],
+//	                    "scoreUnit": "us/op", "rawData": [[12.0, 12.5, 13.0], [12.25, 12.5, 12.75]]},
+//	  "secondaryMetrics": {"·gc.alloc.rate": {"score": 310.5, "scoreUnit": "MB/sec", ...}}}]
+//
+// Features:
+//   - Each benchmark and parameter combination is a result named
+//     "benchmark:key=value,..." with the parameters sorted by name
+//   - Time-per-operation scores (ns/op, us/op, ms/op, s/op, ...) are the time
+//   - Throughput scores (ops/ns, ops/us, ops/ms, ops/s, ...) become ops/s
+//     throughput, and the time is derived from it
+//   - rawData (forks × iterations) becomes the samples, in time per operation,
+//     and SampleCount their number; Iterations is left at 0
+//   - Benchmarks, secondary metrics and samples whose score is "NaN" are skipped
+//   - Score, unit, error and confidence interval are kept in metadata, as are
+//     mode, forks, threads and the parameters ("param.<name>")
+//   - Secondary metrics become metrics named without JMH's "·" prefix;
+//     ops/... units are higher-is-better
+//   - JMH version, JDK and VM are kept as suite metadata
+//   - A console log before the JSON array is skipped
+//
+// # BenchmarkDotNet Parser Specifics
+//
+// BenchmarkDotNetParser reads the reports of the JSON exporter
+// (BenchmarkDotNet.Artifacts/results/*-report-full-compressed.json):
+//
+//	{"Title": "...", "HostEnvironmentInfo": {"ProcessorName": "AMD EPYC 7763", "RuntimeVersion": ".NET 8.0.1", ...},
+//	 "Benchmarks": [{"DisplayInfo": "Md5VsSha256.Sha256: DefaultJob [N=1000]", "FullName": "MyBenchmarks.Md5VsSha256.Sha256(N: 1000)",
+//	   "Parameters": "N=1000", "Statistics": {"N": 15, "Mean": 5000.0, "Median": 4990.0, "StandardDeviation": 80.0, ...},
+//	   "Memory": {"Gen0Collections": 2, "TotalOperations": 4096, "BytesAllocatedPerOperation": 112, ...}}]}
+//
+// Features:
+//   - Results are named after FullName; a method run under several jobs is
+//     qualified with the job ("... [.NET 8.0]")
+//   - Time is the mean, StdDev the standard deviation and SampleCount N (times in ns)
+//   - Iterations is the operation count of each measured workload iteration
+//   - OriginalValues become the samples
+//   - Median, min, max, quartiles, percentiles ("p50_ns", "p95_ns", ...) and the
+//     confidence interval are kept in metadata, with job and parameters
+//   - Memory becomes the "bytes_per_op" metric and Gen0/1/2 collections per
+//     1000 operations ("gen0_collections", ...) when MemoryDiagnoser is used;
+//     allocation counts aren't reported, so aggregated results carry bytes only
+//   - Benchmarks without Statistics (failed runs) are skipped
+//   - HostEnvironmentInfo (OS, CPU, runtime, architecture, cores) is kept as suite metadata
+//   - Several reports may be concatenated; a console log before the first is skipped
+//
+// # hyperfine Parser Specifics
+//
+// HyperfineParser reads the JSON written with `hyperfine --export-json` (times in seconds):
+//
+//	{"results": [{"command": "make -j 4", "mean": 0.75, "stddev": 0.05, "median": 0.74,
+//	  "user": 2.1, "system": 0.4, "min": 0.7, "max": 0.81, "times": [0.7, 0.74, 0.81],
+//	  "exit_codes": [0, 0, 0], "parameters": {"threads": "4"}}]}
+//
+// Features:
+//   - Each command becomes a result; Time is the mean and the per-run times are the samples
+//   - Median, min and max are kept in metadata ("median_ns", "min_ns", "max_ns")
+//   - User and system CPU time become the "user_time" and "system_time" metrics (s)
+//   - Exit codes are kept as the distinct codes ("exit_codes", "signal" for runs
+//     killed by a signal) and the number of failed runs ("failed_runs")
+//   - Commands of a parameter sweep are named after the command with the parameter
+//     values replaced by placeholders, followed by the values:
+//     "make -j {threads} [threads=4]". The template is kept as "group"
+//
+// # vitest Parser Specifics
+//
+// VitestParser reads the report written with `vitest bench --outputJson <file>`
+// (tinybench statistics, times in milliseconds):
+//
+//	{"files": [{"filepath": "/project/bench/sort.bench.ts", "groups": [
+//	  {"fullName": "bench/sort.bench.ts > sorting", "benchmarks": [
+//	    {"name": "Array.prototype.sort", "rank": 1, "hz": 12345.6, "mean": 0.081, "sd": 0.017,
+//	     "rme": 0.52, "min": 0.071, "max": 0.41, "median": 0.079, "p75": 0.082, "p99": 0.15,
+//	     "sampleCount": 6173}]}]}]}
+//
+// Features:
+//   - Results are named after the file and describe() blocks:
+//     "bench/sort.bench.ts > sorting > Array.prototype.sort"; the prefix is kept as "group"
+//   - Time is the mean and hz the ops/s throughput; sd is the standard deviation
+//   - rme, rank, median/min/max and p75/p99/p995/p999 (in ns) are kept in metadata
+//   - Samples are kept when written with --includeSamples
+//
+// # mitata Parser Specifics
+//
+// MitataParser reads the JSON mitata prints with `run({ format: 'json' })`
+// (times in nanoseconds):
+//
+//	{"layout": [{"name": null}, {"name": "sorting"}],
+//	 "benchmarks": [{"alias": "sort($size)", "group": 1, "runs": [{"name": "sort(100)",
+//	   "args": {"size": 100}, "stats": {"min": 1800, "max": 2400, "avg": 2000, "p25": 1900,
+//	   "p50": 2000, "p75": 2100, "p99": 2400, "p999": 2400, "ticks": 4}}]}],
+//	 "context": {"runtime": "node", "version": "20.14.0", "cpu": {"name": "...", "freq": 3.2}}}
+//
+// Features:
+//   - Each run (argument combination) becomes a result; benchmarks in a group()
+//     are named "sorting > sort(100)", with "group" and "param.<name>" labels
+//   - Time is the average and the ops/s throughput is derived from it
+//   - Percentiles, min and max are kept in metadata (p50 as "median_ns", p25 as "q1_ns")
+//   - With samples ({ samples: true }), the standard deviation and "rme" are computed
+//   - Runs with an error are skipped; the 0.x format (stats on the benchmark, group
+//     names, top-level cpu and runtime) is also accepted
+//
+// # Deno Parser Specifics
+//
+// DenoParser reads the output of `deno bench --json` (times in nanoseconds):
+//
+//	{"version": 1, "runtime": "Deno/1.40.2 x86_64-unknown-linux-gnu", "cpu": "...",
+//	 "benches": [{"origin": "file:///project/bench/sort_bench.ts", "group": "sorting",
+//	   "name": "Array.sort", "baseline": false, "results": [{"ok": {"n": 51234,
+//	   "min": 8120, "max": 35010, "avg": 9040, "p75": 8900, "p99": 15020, "p995": 20001,
+//	   "p999": 30002, "highPrecision": true, "usedExplicitTimers": false}}]}]}
+//
+// Features:
+//   - Results are named after the module, relative to the directory shared by all
+//     modules, the group and the name: "sort_bench.ts > sorting > Array.sort"
+//   - Time is the average and the ops/s throughput is derived from it; n is the iterations
+//   - min/max and p75/p99/p995/p999 (in ns) are kept in metadata
+//   - Failed benchmarks are skipped; runtime and CPU are kept as suite metadata
+//
 // # Go Parser Specifics
 //
 // The Go parser supports testing.B output format:
@@ -160,6 +445,7 @@
 //	BenchmarkSort-8         1000000              1234 ns/op             512 B/op          10 allocs/op
 //	BenchmarkSearch-8       5000000               234 ns/op               0 B/op           0 allocs/op
 //	BenchmarkInsert-8        500000              3456 ns/op            1024 B/op          20 allocs/op
+//	BenchmarkCache-8         1000000              1200 ns/op           85.33 MB/s         4.5 hits/op
 //
 //	PASS
 //	ok      github.com/example/benchmarks    2.456s
@@ -170,8 +456,20 @@
 //   - Extracts iterations (N) and ns/op (mean time)
 //   - Optional memory metrics: B/op (bytes per operation), allocs/op (allocations per operation)
 //   - Handles optional fields when -benchmem flag is not used
+//   - Reads every "value unit" pair on the line: MB/s (b.SetBytes) becomes Throughput,
+//     and any other unit (b.ReportMetric) becomes a named Metric called after its unit.
+//     Units ending in "/s" are higher-is-better; all others are lower-is-better
 //   - Skips debug output (--- BENCH: lines, file references)
 //   - Supports various Go GOMAXPROCS suffixes (-1, -8, -16, -32, etc.)
+//   - Groups repeated runs (`go test -count=N`) into one result with raw samples,
+//     mean time, sample standard deviation, and median (metadata "median_ns")
+//   - Records the goos/goarch/pkg/cpu headers on each result (metadata "goos",
+//     "goarch", "package", "cpu")
+//   - Accepts `go test -json -bench` event streams: "output" events are
+//     reassembled per package (test2json may split a benchmark line across
+//     events) and parsed like text output
+//   - A benchmark name found in more than one package is qualified with the
+//     import path (e.g., "example.com/a.BenchmarkSort-8") so results don't collide
 //
 // Edge cases handled:
 //   - Zero allocations: B/op and allocs/op omitted from metadata
@@ -184,6 +482,5 @@
 // # Future Extensions
 //
 // Planned additions:
-//   - Criterion format parser with histogram data
 //   - Custom format support via configuration
 package parser
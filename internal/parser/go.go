@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -24,8 +25,12 @@ func (p *GoParser) Language() string {
 }
 
 // Parse parses Go testing.B output
-// Expected format: BenchmarkName-N  iterations  ns/op  [B/op  allocs/op]
-// Example: BenchmarkSort-8  1000000  1234 ns/op  512 B/op  10 allocs/op
+// Expected format: BenchmarkName-N  iterations  value unit  [value unit ...]
+// Example: BenchmarkSort-8  1000000  1234 ns/op  83.2 MB/s  512 B/op  10 allocs/op  4.5 hits/op
+// MB/s (b.SetBytes) becomes Throughput, B/op and allocs/op become Memory, and any other
+// unit (b.ReportMetric) becomes a named Metric.
+// Repeated lines for the same benchmark (go test -count=N) are grouped into one result.
+// A `go test -json` event stream is detected and reassembled per package before parsing.
 func (p *GoParser) Parse(output []byte) (*BenchmarkSuite, error) {
 	suite := &BenchmarkSuite{
 		Language:  "go",
@@ -34,42 +39,83 @@ func (p *GoParser) Parse(output []byte) (*BenchmarkSuite, error) {
 		Metadata:  make(map[string]string),
 	}
 
+	packages := []goPackageOutput{{output: output}}
+	if isGoTestJSON(output) {
+		var err error
+		packages, err = reassembleGoTestJSON(output)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	collector := newGoBenchmarkCollector()
+	for _, pkg := range packages {
+		if err := collector.parse(pkg.name, pkg.output); err != nil {
+			return nil, err
+		}
+	}
+	suite.Results = collector.results()
+	for key, value := range collector.metadata() {
+		suite.Metadata[key] = value
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// Regex for benchmark line: BenchmarkName-N  iterations  value unit  [value unit ...]
+// Pattern explanation:
+// - ^Benchmark(\S+): starts with "Benchmark" followed by name/suffix (no space)
+// - \s+(\d+): iterations
+// - \s+(.+)$: the remaining "value unit" pairs (ns/op, MB/s, B/op, allocs/op, custom units)
+var goBenchRegex = regexp.MustCompile(`^Benchmark(\S+)\s+(\d+)\s+(.+)$`)
+
+// goBenchmarkCollector groups benchmark lines by package and name, in order of first appearance
+type goBenchmarkCollector struct {
+	groups map[string]*goBenchmarkGroup
+	order  []string
+}
+
+func newGoBenchmarkCollector() *goBenchmarkCollector {
+	return &goBenchmarkCollector{groups: make(map[string]*goBenchmarkGroup)}
+}
+
+// parse reads testing.B text output. pkg is the package the output belongs to;
+// "pkg:" header lines override it, so plain `go test ./...` output is attributed too.
+func (c *goBenchmarkCollector) parse(pkg string, output []byte) error {
 	scanner := bufio.NewScanner(bytes.NewReader(output))
 	lineNum := 0
 
-	// Regex for benchmark line: BenchmarkName-N  iterations  ns/op  [B/op  allocs/op]
-	// Pattern explanation:
-	// - ^Benchmark(\S+): starts with "Benchmark" followed by name/suffix (no space)
-	// - \s+: whitespace separator
-	// - (\d+): iterations
-	// - \s+: whitespace
-	// - (\d+(?:\.\d+)?): time value (integer or float)
-	// - \s+ns/op: literal "ns/op"
-	// - (?:\s+(\d+)\s+B/op)?: optional bytes per op
-	// - (?:\s+(\d+)\s+allocs/op)?: optional allocs per op
-	benchRegex := regexp.MustCompile(
-		`^Benchmark(\S+)\s+(\d+)\s+(\d+(?:\.\d+)?)\s+ns/op(?:\s+(\d+)\s+B/op)?(?:\s+(\d+)\s+allocs/op)?`,
-	)
+	// Environment headers printed before each package's benchmarks
+	header := goHeader{pkg: pkg}
 
 	for scanner.Scan() {
 		lineNum++
 		line := strings.TrimSpace(scanner.Text())
 
+		// Record goos/goarch/pkg/cpu headers for the benchmarks that follow
+		if header.update(line) {
+			continue
+		}
+
 		// Skip empty lines and non-benchmark lines
 		if line == "" || !strings.HasPrefix(line, "Benchmark") {
 			continue
 		}
 
-		// Skip lines with FAIL, PASS, --- (debug output), ok, goos, goarch, pkg, cpu
+		// Skip lines with FAIL, PASS, --- (debug output), ok
 		if strings.Contains(line, "FAIL") || strings.Contains(line, "PASS") ||
-			strings.HasPrefix(line, "---") || strings.HasPrefix(line, "ok ") ||
-			strings.HasPrefix(line, "goos:") || strings.HasPrefix(line, "goarch:") ||
-			strings.HasPrefix(line, "pkg:") || strings.HasPrefix(line, "cpu:") {
+			strings.HasPrefix(line, "---") || strings.HasPrefix(line, "ok ") {
 			continue
 		}
 
 		// Match benchmark line
-		matches := benchRegex.FindStringSubmatch(line)
+		matches := goBenchRegex.FindStringSubmatch(line)
 		if matches == nil {
 			// Line starts with "Benchmark" but doesn't match format - might be error
 			continue
@@ -78,14 +124,16 @@ func (p *GoParser) Parse(output []byte) (*BenchmarkSuite, error) {
 		// Extract fields (group 0 is full match, 1+ are capture groups)
 		// Group 1: name (e.g., "Sort-8")
 		// Group 2: iterations
-		// Group 3: time
-		// Group 4: bytes per op (optional)
-		// Group 5: allocs per op (optional)
+		// Group 3: "value unit" pairs
 		nameStr := matches[1]
 		iterationsStr := matches[2]
-		timeStr := matches[3]
-		bytesOpStr := matches[4]  // Optional
-		allocsOpStr := matches[5] // Optional
+		values := parseGoMetricPairs(matches[3])
+
+		// ns/op is always reported first; lines without it are not results
+		if len(values) == 0 || values[0].unit != "ns/op" {
+			continue
+		}
+		timeFloat := values[0].value
 
 		// Reconstruct full name with "Benchmark" prefix
 		name := "Benchmark" + nameStr
@@ -93,71 +141,264 @@ func (p *GoParser) Parse(output []byte) (*BenchmarkSuite, error) {
 		// Parse iterations
 		iterations, err := strconv.ParseInt(iterationsStr, 10, 64)
 		if err != nil {
-			return nil, &ParseError{
+			return &ParseError{
 				Line:    lineNum,
 				Message: fmt.Sprintf("failed to parse iterations: %v", err),
 				Input:   line,
 			}
 		}
 
-		// Parse time (can be float like 10.5)
-		timeFloat, err := strconv.ParseFloat(timeStr, 64)
-		if err != nil {
-			return nil, &ParseError{
-				Line:    lineNum,
-				Message: fmt.Sprintf("failed to parse time: %v", err),
-				Input:   line,
-			}
-		}
-
 		// Convert from nanoseconds to time.Duration
 		timeNs := int64(timeFloat)
 		if timeNs < 0 {
-			return nil, &ParseError{
+			return &ParseError{
 				Line:    lineNum,
 				Message: fmt.Sprintf("invalid time value: %f", timeFloat),
 				Input:   line,
 			}
 		}
 
-		// Create benchmark result
-		result := &BenchmarkResult{
-			Name:       name,
-			Language:   "go",
-			Time:       time.Duration(timeNs) * time.Nanosecond,
-			Iterations: iterations,
-			StdDev:     0, // Go testing.B doesn't report stddev
-			Metadata:   make(map[string]string),
+		// Group repeated runs (go test -count=N) under a single result per package
+		key := header.pkg + "\x00" + name
+		group, exists := c.groups[key]
+		if !exists {
+			group = &goBenchmarkGroup{name: name, header: header, metrics: make(map[string][]float64)}
+			c.groups[key] = group
+			c.order = append(c.order, key)
 		}
 
-		// Parse optional B/op field
-		if bytesOpStr != "" {
-			bytesOp, err := strconv.ParseInt(bytesOpStr, 10, 64)
-			if err == nil && bytesOp > 0 {
-				result.Metadata["bytes_per_op"] = fmt.Sprintf("%d", bytesOp)
+		group.samples = append(group.samples, time.Duration(timeNs)*time.Nanosecond)
+		group.iterations += iterations
+
+		// Remaining pairs: memory, throughput (b.SetBytes) and b.ReportMetric values
+		for _, v := range values[1:] {
+			switch v.unit {
+			case "B/op":
+				group.bytesPerOp = append(group.bytesPerOp, int64(math.Round(v.value)))
+			case "allocs/op":
+				group.allocsPerOp = append(group.allocsPerOp, int64(math.Round(v.value)))
+			case "MB/s":
+				group.mbPerSec = append(group.mbPerSec, v.value)
+			default:
+				if _, seen := group.metrics[v.unit]; !seen {
+					group.metricOrder = append(group.metricOrder, v.unit)
+				}
+				group.metrics[v.unit] = append(group.metrics[v.unit], v.value)
 			}
 		}
+	}
 
-		// Parse optional allocs/op field
-		if allocsOpStr != "" {
-			allocsOp, err := strconv.ParseInt(allocsOpStr, 10, 64)
-			if err == nil && allocsOp > 0 {
-				result.Metadata["allocs_per_op"] = fmt.Sprintf("%d", allocsOp)
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading input: %w", err)
+	}
+
+	return nil
+}
+
+// results builds one result per benchmark. A name that occurs in more than one
+// package is qualified with its import path ("example.com/a.BenchmarkSort-8")
+// so the results don't collide when compared.
+func (c *goBenchmarkCollector) results() []*BenchmarkResult {
+	packagesByName := make(map[string]map[string]bool)
+	for _, key := range c.order {
+		g := c.groups[key]
+		if packagesByName[g.name] == nil {
+			packagesByName[g.name] = make(map[string]bool)
+		}
+		packagesByName[g.name][g.header.pkg] = true
+	}
+
+	results := make([]*BenchmarkResult, 0, len(c.order))
+	for _, key := range c.order {
+		g := c.groups[key]
+		result := g.result()
+		if len(packagesByName[g.name]) > 1 && g.header.pkg != "" {
+			result.Name = g.header.pkg + "." + g.name
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// metadata returns the environment of the run for the suite: the first goos, goarch
+// and cpu reported, and every package with benchmarks (comma-separated)
+func (c *goBenchmarkCollector) metadata() map[string]string {
+	metadata := make(map[string]string)
+	var packages []string
+	seen := make(map[string]bool)
+
+	for _, key := range c.order {
+		h := c.groups[key].header
+		for k, v := range map[string]string{MetadataGOOS: h.goos, MetadataGOARCH: h.goarch, MetadataCPU: h.cpu} {
+			if _, ok := metadata[k]; !ok && v != "" {
+				metadata[k] = v
 			}
 		}
+		if h.pkg != "" && !seen[h.pkg] {
+			seen[h.pkg] = true
+			packages = append(packages, h.pkg)
+		}
+	}
 
-		suite.Results = append(suite.Results, result)
+	if len(packages) > 0 {
+		metadata[MetadataPackage] = strings.Join(packages, ", ")
 	}
+	return metadata
+}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading input: %w", err)
+// goHeader holds the environment lines `go test -bench` prints per package
+type goHeader struct {
+	goos   string
+	goarch string
+	pkg    string
+	cpu    string
+}
+
+// update records a goos/goarch/pkg/cpu header line and reports whether line was one
+func (h *goHeader) update(line string) bool {
+	key, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return false
+	}
+	value = strings.TrimSpace(value)
+	switch key {
+	case "goos":
+		h.goos = value
+	case "goarch":
+		h.goarch = value
+	case "pkg":
+		h.pkg = value
+	case "cpu":
+		h.cpu = value
+	default:
+		return false
 	}
+	return true
+}
 
-	if len(suite.Results) == 0 {
-		return nil, &ParseError{
-			Message: "no benchmark results found in output",
+// goMetricPair is one "value unit" pair from a benchmark line
+type goMetricPair struct {
+	value float64
+	unit  string
+}
+
+// parseGoMetricPairs splits "1234 ns/op  512 B/op" into value/unit pairs,
+// stopping at the first token that isn't a number
+func parseGoMetricPairs(s string) []goMetricPair {
+	fields := strings.Fields(s)
+	pairs := make([]goMetricPair, 0, len(fields)/2)
+	for i := 0; i+1 < len(fields); i += 2 {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			break
 		}
+		pairs = append(pairs, goMetricPair{value: value, unit: fields[i+1]})
 	}
+	return pairs
+}
 
-	return suite, nil
+// goBenchmarkGroup collects repeated runs of a single Go benchmark
+type goBenchmarkGroup struct {
+	name        string
+	header      goHeader // environment the benchmark ran in
+	samples     []time.Duration
+	iterations  int64
+	bytesPerOp  []int64
+	allocsPerOp []int64
+	mbPerSec    []float64
+	metrics     map[string][]float64 // custom b.ReportMetric values by unit
+	metricOrder []string             // custom units in order of first appearance
+}
+
+// result builds a BenchmarkResult from the collected runs
+func (g *goBenchmarkGroup) result() *BenchmarkResult {
+	mean, median, stdDev := summarizeSamples(g.samples)
+
+	result := &BenchmarkResult{
+		Name:       g.name,
+		Language:   "go",
+		Time:       mean,
+		Iterations: g.iterations,
+		StdDev:     stdDev, // Zero for a single run; Go testing.B doesn't report stddev
+		Metadata:   make(map[string]string),
+	}
+
+	// Package and environment the benchmark ran in, when the output includes them
+	for key, value := range map[string]string{
+		MetadataPackage: g.header.pkg,
+		MetadataGOOS:    g.header.goos,
+		MetadataGOARCH:  g.header.goarch,
+		MetadataCPU:     g.header.cpu,
+	} {
+		if value != "" {
+			result.Metadata[key] = value
+		}
+	}
+
+	// Keep raw samples only when there are repeated runs to describe variance
+	if len(g.samples) > 1 {
+		result.Samples = g.samples
+		result.Metadata["runs"] = fmt.Sprintf("%d", len(g.samples))
+		result.Metadata[MetadataMedianNs] = fmt.Sprintf("%d", median.Nanoseconds())
+	}
+
+	// Memory metrics are averaged across runs. Zero values are kept in Memory
+	// (the benchmark measured no allocations) but omitted from Metadata.
+	bytesOp := meanInt64(g.bytesPerOp)
+	allocsOp := meanInt64(g.allocsPerOp)
+	if len(g.bytesPerOp) > 0 || len(g.allocsPerOp) > 0 {
+		result.Memory = &MemoryStats{BytesPerOp: bytesOp, AllocsPerOp: allocsOp}
+	}
+	if bytesOp > 0 {
+		result.Metadata["bytes_per_op"] = fmt.Sprintf("%d", bytesOp)
+	}
+	if allocsOp > 0 {
+		result.Metadata["allocs_per_op"] = fmt.Sprintf("%d", allocsOp)
+	}
+
+	// Throughput from b.SetBytes is averaged across runs
+	if len(g.mbPerSec) > 0 {
+		result.Throughput = &Throughput{Value: meanFloat64(g.mbPerSec), Unit: "MB/s"}
+	}
+
+	// Custom metrics are named after their unit. Rates ("/s") are higher-is-better.
+	for _, unit := range g.metricOrder {
+		values := g.metrics[unit]
+		metric := Metric{
+			Name:           unit,
+			Unit:           unit,
+			Value:          meanFloat64(values),
+			HigherIsBetter: strings.HasSuffix(unit, "/s"),
+		}
+		if len(values) > 1 {
+			metric.Samples = values
+		}
+		result.Metrics = append(result.Metrics, metric)
+	}
+
+	return result
+}
+
+// meanFloat64 returns the mean of the values, or 0 if there are none
+func meanFloat64(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// meanInt64 returns the rounded mean of the values, or 0 if there are none
+func meanInt64(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum int64
+	for _, v := range values {
+		sum += v
+	}
+	return int64(math.Round(float64(sum) / float64(len(values))))
 }
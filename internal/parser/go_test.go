@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"math"
 	"os"
 	"testing"
 	"time"
@@ -41,6 +42,17 @@ ok      github.com/example/benchmarks    2.456s`)
 	if suite.Language != "go" {
 		t.Errorf("Suite.Language = %v, want %v", suite.Language, "go")
 	}
+	wantMetadata := map[string]string{
+		MetadataGOOS:    "darwin",
+		MetadataGOARCH:  "arm64",
+		MetadataPackage: "github.com/example/benchmarks",
+		MetadataCPU:     "Apple M1",
+	}
+	for key, want := range wantMetadata {
+		if got := suite.Metadata[key]; got != want {
+			t.Errorf("Suite.Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
 
 	// Check number of results
 	if len(suite.Results) != 3 {
@@ -67,6 +79,9 @@ ok      github.com/example/benchmarks    2.456s`)
 	if first.Metadata["allocs_per_op"] != "10" {
 		t.Errorf("Results[0].Metadata['allocs_per_op'] = %v, want %v", first.Metadata["allocs_per_op"], "10")
 	}
+	if first.Memory == nil || first.Memory.BytesPerOp != 512 || first.Memory.AllocsPerOp != 10 {
+		t.Errorf("Results[0].Memory = %+v, want {512 10}", first.Memory)
+	}
 
 	// Verify second benchmark (no memory allocation)
 	second := suite.Results[1]
@@ -86,6 +101,10 @@ ok      github.com/example/benchmarks    2.456s`)
 	if _, ok := second.Metadata["allocs_per_op"]; ok {
 		t.Errorf("Results[1] should not have allocs_per_op for zero allocation")
 	}
+	// Zero allocations are still a measurement
+	if second.Memory == nil || second.Memory.BytesPerOp != 0 || second.Memory.AllocsPerOp != 0 {
+		t.Errorf("Results[1].Memory = %+v, want {0 0}", second.Memory)
+	}
 }
 
 func TestGoParser_Parse_FromFile(t *testing.T) {
@@ -302,6 +321,9 @@ func TestGoParser_Parse_NoMemory(t *testing.T) {
 	if _, ok := first.Metadata["allocs_per_op"]; ok {
 		t.Errorf("Results[0] should not have allocs_per_op when not reported")
 	}
+	if first.Memory != nil {
+		t.Errorf("Results[0].Memory = %+v, want nil when not reported", first.Memory)
+	}
 }
 
 func TestGoParser_Parse_EdgeCasesFromFile(t *testing.T) {
@@ -425,3 +447,271 @@ PASS`)
 		}
 	}
 }
+
+func TestGoParser_Parse_RepeatedCount(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/go/testing_b_count.txt")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+		return
+	}
+
+	parser := NewGoParser()
+	suite, err := parser.Parse(data)
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	// Repeated lines should be grouped into one result per benchmark
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want %d", len(suite.Results), 2)
+	}
+
+	sortResult := suite.Results[0]
+	if sortResult.Name != "BenchmarkSort-16" {
+		t.Errorf("Results[0].Name = %v, want BenchmarkSort-16", sortResult.Name)
+	}
+	if len(sortResult.Samples) != 5 {
+		t.Fatalf("len(Results[0].Samples) = %d, want 5", len(sortResult.Samples))
+	}
+	if sortResult.Samples[0] != 1000*time.Nanosecond || sortResult.Samples[4] != 1300*time.Nanosecond {
+		t.Errorf("Results[0].Samples = %v, want samples in input order", sortResult.Samples)
+	}
+	if sortResult.Time != 1100*time.Nanosecond {
+		t.Errorf("Results[0].Time = %v, want mean %v", sortResult.Time, 1100*time.Nanosecond)
+	}
+	// Sample stddev of 900..1300 in steps of 100 is sqrt(25000) ≈ 158.11ns
+	if sortResult.StdDev != 158*time.Nanosecond {
+		t.Errorf("Results[0].StdDev = %v, want %v", sortResult.StdDev, 158*time.Nanosecond)
+	}
+	if sortResult.Iterations != 5000000 {
+		t.Errorf("Results[0].Iterations = %d, want %d", sortResult.Iterations, 5000000)
+	}
+	if sortResult.Metadata["runs"] != "5" {
+		t.Errorf("Results[0].Metadata['runs'] = %v, want 5", sortResult.Metadata["runs"])
+	}
+	if sortResult.Metadata["median_ns"] != "1100" {
+		t.Errorf("Results[0].Metadata['median_ns'] = %v, want 1100", sortResult.Metadata["median_ns"])
+	}
+	if sortResult.Metadata["bytes_per_op"] != "512" {
+		t.Errorf("Results[0].Metadata['bytes_per_op'] = %v, want 512", sortResult.Metadata["bytes_per_op"])
+	}
+
+	searchResult := suite.Results[1]
+	if len(searchResult.Samples) != 3 {
+		t.Errorf("len(Results[1].Samples) = %d, want 3", len(searchResult.Samples))
+	}
+	if searchResult.Time != 200*time.Nanosecond {
+		t.Errorf("Results[1].Time = %v, want %v", searchResult.Time, 200*time.Nanosecond)
+	}
+	if searchResult.StdDev != 10*time.Nanosecond {
+		t.Errorf("Results[1].StdDev = %v, want %v", searchResult.StdDev, 10*time.Nanosecond)
+	}
+	if _, ok := searchResult.Metadata["bytes_per_op"]; ok {
+		t.Error("Results[1] should not have bytes_per_op metadata for zero allocations")
+	}
+}
+
+func TestGoParser_Parse_SingleRunHasNoSamples(t *testing.T) {
+	input := []byte(`BenchmarkOnce-8       1000000              1234 ns/op`)
+
+	parser := NewGoParser()
+	suite, err := parser.Parse(input)
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	result := suite.Results[0]
+	if result.Samples != nil {
+		t.Errorf("Samples = %v, want nil for a single run", result.Samples)
+	}
+	if result.StdDev != 0 {
+		t.Errorf("StdDev = %v, want 0 for a single run", result.StdDev)
+	}
+	if result.Time != 1234*time.Nanosecond {
+		t.Errorf("Time = %v, want %v", result.Time, 1234*time.Nanosecond)
+	}
+}
+
+func TestGoParser_Parse_CustomMetrics(t *testing.T) {
+	input := []byte(`BenchmarkCache-8    1000000    1200 ns/op    85.33 MB/s    4.5 hits/op    0.98 p99-ms    512 B/op    3 allocs/op
+BenchmarkCache-8    1000000    1000 ns/op    102.40 MB/s    5.5 hits/op    1.02 p99-ms    512 B/op    3 allocs/op
+BenchmarkPlain-8    2000000     600 ns/op`)
+
+	parser := NewGoParser()
+	suite, err := parser.Parse(input)
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+
+	cache := suite.Results[0]
+	if cache.Time != 1100*time.Nanosecond {
+		t.Errorf("Time = %v, want %v", cache.Time, 1100*time.Nanosecond)
+	}
+	if cache.Throughput == nil || cache.Throughput.Unit != "MB/s" {
+		t.Fatalf("Throughput = %+v, want MB/s", cache.Throughput)
+	}
+	if math.Abs(cache.Throughput.Value-93.865) > 1e-9 {
+		t.Errorf("Throughput.Value = %v, want 93.865", cache.Throughput.Value)
+	}
+	if cache.Memory == nil || cache.Memory.BytesPerOp != 512 || cache.Memory.AllocsPerOp != 3 {
+		t.Errorf("Memory = %+v, want 512 B/op and 3 allocs/op", cache.Memory)
+	}
+
+	if len(cache.Metrics) != 2 {
+		t.Fatalf("len(Metrics) = %d, want 2", len(cache.Metrics))
+	}
+	hits := cache.Metrics[0]
+	if hits.Name != "hits/op" || hits.Unit != "hits/op" || hits.Value != 5.0 {
+		t.Errorf("Metrics[0] = %+v, want hits/op with mean 5", hits)
+	}
+	if len(hits.Samples) != 2 || hits.Samples[0] != 4.5 || hits.Samples[1] != 5.5 {
+		t.Errorf("Metrics[0].Samples = %v, want [4.5 5.5]", hits.Samples)
+	}
+	p99 := cache.Metrics[1]
+	if p99.Name != "p99-ms" || math.Abs(p99.Value-1.0) > 1e-9 || p99.HigherIsBetter {
+		t.Errorf("Metrics[1] = %+v, want lower-is-better p99-ms with mean 1.0", p99)
+	}
+
+	plain := suite.Results[1]
+	if plain.Throughput != nil || plain.Memory != nil || plain.Metrics != nil {
+		t.Errorf("Results[1] = %+v, want no throughput, memory or metrics", plain)
+	}
+}
+
+func TestGoParser_Parse_RateMetricIsHigherBetter(t *testing.T) {
+	input := []byte(`BenchmarkServe-8    1000    52000 ns/op    19230 req/s`)
+
+	parser := NewGoParser()
+	suite, err := parser.Parse(input)
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	result := suite.Results[0]
+	if len(result.Metrics) != 1 {
+		t.Fatalf("len(Metrics) = %d, want 1", len(result.Metrics))
+	}
+	if m := result.Metrics[0]; m.Name != "req/s" || !m.HigherIsBetter || m.Samples != nil {
+		t.Errorf("Metrics[0] = %+v, want higher-is-better req/s without samples", m)
+	}
+}
+
+func TestGoParser_Parse_TestJSONStream(t *testing.T) {
+	input := []byte(`{"Time":"2026-01-05T10:00:00Z","Action":"start","Package":"example.com/cache"}
+{"Time":"2026-01-05T10:00:00Z","Action":"output","Package":"example.com/cache","Output":"goos: linux\n"}
+{"Time":"2026-01-05T10:00:00Z","Action":"output","Package":"example.com/cache","Output":"goarch: amd64\n"}
+{"Time":"2026-01-05T10:00:00Z","Action":"output","Package":"example.com/cache","Output":"pkg: example.com/cache\n"}
+{"Time":"2026-01-05T10:00:00Z","Action":"output","Package":"example.com/cache","Output":"cpu: AMD EPYC 7763 64-Core Processor\n"}
+{"Time":"2026-01-05T10:00:00Z","Action":"start","Package":"example.com/store"}
+{"Time":"2026-01-05T10:00:00Z","Action":"output","Package":"example.com/cache","Test":"BenchmarkGet","Output":"BenchmarkGet-8   \t"}
+{"Time":"2026-01-05T10:00:00Z","Action":"output","Package":"example.com/store","Output":"goos: linux\n"}
+{"Time":"2026-01-05T10:00:00Z","Action":"output","Package":"example.com/store","Output":"pkg: example.com/store\n"}
+{"Time":"2026-01-05T10:00:01Z","Action":"output","Package":"example.com/cache","Test":"BenchmarkGet","Output":" 1000000\t      1200 ns/op\t     512 B/op\t       3 allocs/op\n"}
+{"Time":"2026-01-05T10:00:01Z","Action":"output","Package":"example.com/store","Test":"BenchmarkGet","Output":"BenchmarkGet-8   \t  500000\t      2400 ns/op\n"}
+{"Time":"2026-01-05T10:00:01Z","Action":"output","Package":"example.com/store","Test":"BenchmarkPut","Output":"BenchmarkPut-8   \t  300000\t      3600 ns/op\n"}
+{"Time":"2026-01-05T10:00:02Z","Action":"output","Package":"example.com/cache","Output":"PASS\n"}
+{"Time":"2026-01-05T10:00:02Z","Action":"pass","Package":"example.com/cache","Elapsed":2.1}
+{"Time":"2026-01-05T10:00:02Z","Action":"pass","Package":"example.com/store","Elapsed":2.3}`)
+
+	parser := NewGoParser()
+	suite, err := parser.Parse(input)
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(suite.Results))
+	}
+
+	tests := []struct {
+		name    string
+		time    time.Duration
+		pkg     string
+		goarch  string
+		hasMem  bool
+		wantCPU string
+	}{
+		{"example.com/cache.BenchmarkGet-8", 1200 * time.Nanosecond, "example.com/cache", "amd64", true, "AMD EPYC 7763 64-Core Processor"},
+		{"example.com/store.BenchmarkGet-8", 2400 * time.Nanosecond, "example.com/store", "", false, ""},
+		{"BenchmarkPut-8", 3600 * time.Nanosecond, "example.com/store", "", false, ""},
+	}
+
+	for i, tt := range tests {
+		result := suite.Results[i]
+		if result.Name != tt.name {
+			t.Errorf("Results[%d].Name = %q, want %q", i, result.Name, tt.name)
+		}
+		if result.Time != tt.time {
+			t.Errorf("Results[%d].Time = %v, want %v", i, result.Time, tt.time)
+		}
+		if result.Metadata["package"] != tt.pkg {
+			t.Errorf("Results[%d].Metadata['package'] = %q, want %q", i, result.Metadata["package"], tt.pkg)
+		}
+		if result.Metadata["goos"] != "linux" {
+			t.Errorf("Results[%d].Metadata['goos'] = %q, want linux", i, result.Metadata["goos"])
+		}
+		if result.Metadata["goarch"] != tt.goarch {
+			t.Errorf("Results[%d].Metadata['goarch'] = %q, want %q", i, result.Metadata["goarch"], tt.goarch)
+		}
+		if result.Metadata["cpu"] != tt.wantCPU {
+			t.Errorf("Results[%d].Metadata['cpu'] = %q, want %q", i, result.Metadata["cpu"], tt.wantCPU)
+		}
+		if (result.Memory != nil) != tt.hasMem {
+			t.Errorf("Results[%d].Memory = %+v, want present = %v", i, result.Memory, tt.hasMem)
+		}
+	}
+}
+
+func TestGoParser_Parse_TestJSONWithoutBenchmarks(t *testing.T) {
+	input := []byte(`{"Action":"start","Package":"example.com/cache"}
+{"Action":"output","Package":"example.com/cache","Output":"PASS\n"}
+{"Action":"pass","Package":"example.com/cache"}`)
+
+	parser := NewGoParser()
+	_, err := parser.Parse(input)
+
+	if err == nil {
+		t.Error("Parse() error = nil, want error for a stream without benchmarks")
+	}
+}
+
+func TestGoParser_Parse_MultiplePackagesText(t *testing.T) {
+	input := []byte(`goos: linux
+goarch: arm64
+pkg: example.com/a
+BenchmarkSort-4    1000    1000 ns/op
+PASS
+ok      example.com/a    1.0s
+goos: linux
+goarch: arm64
+pkg: example.com/b
+BenchmarkSort-4    1000    2000 ns/op
+PASS
+ok      example.com/b    1.0s`)
+
+	parser := NewGoParser()
+	suite, err := parser.Parse(input)
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2 (one per package)", len(suite.Results))
+	}
+	if suite.Results[0].Name != "example.com/a.BenchmarkSort-4" || suite.Results[1].Name != "example.com/b.BenchmarkSort-4" {
+		t.Errorf("Names = %q, %q, want package-qualified names", suite.Results[0].Name, suite.Results[1].Name)
+	}
+	if suite.Results[1].Metadata["goarch"] != "arm64" {
+		t.Errorf("Results[1].Metadata['goarch'] = %q, want arm64", suite.Results[1].Metadata["goarch"])
+	}
+	if suite.Metadata[MetadataPackage] != "example.com/a, example.com/b" {
+		t.Errorf("Suite.Metadata['package'] = %q, want both packages", suite.Metadata[MetadataPackage])
+	}
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// goTestEvent is a single event of a `go test -json` (test2json) stream
+type goTestEvent struct {
+	Action  string `json:"Action"`
+	Package string `json:"Package"`
+	Output  string `json:"Output"`
+}
+
+// goPackageOutput is the reassembled testing.B output of one package
+type goPackageOutput struct {
+	name   string
+	output []byte
+}
+
+// isGoTestJSON reports whether output looks like a `go test -json` event stream
+func isGoTestJSON(output []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var event goTestEvent
+		return strings.HasPrefix(line, "{") && json.Unmarshal([]byte(line), &event) == nil && event.Action != ""
+	}
+	return false
+}
+
+// reassembleGoTestJSON concatenates the "output" events of each package, in order of
+// first appearance. test2json may split a benchmark line across several events
+// (the name is flushed before the result), so output is joined before it is parsed.
+// Lines that are not JSON events (e.g., build errors on stderr) are ignored.
+func reassembleGoTestJSON(output []byte) ([]goPackageOutput, error) {
+	var packages []goPackageOutput
+	index := make(map[string]int)
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+
+		var event goTestEvent
+		if err := json.Unmarshal(line, &event); err != nil || event.Action != "output" {
+			continue
+		}
+
+		i, ok := index[event.Package]
+		if !ok {
+			i = len(packages)
+			index[event.Package] = i
+			packages = append(packages, goPackageOutput{name: event.Package})
+		}
+		packages[i].output = append(packages[i].output, event.Output...)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading go test -json stream: %w", err)
+	}
+
+	return packages, nil
+}
@@ -0,0 +1,199 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HyperfineParser implements Parser for command-line benchmarks exported with
+// `hyperfine --export-json`
+type HyperfineParser struct{}
+
+// NewHyperfineParser creates a new hyperfine parser
+func NewHyperfineParser() *HyperfineParser {
+	return &HyperfineParser{}
+}
+
+// Language returns the language this parser supports
+func (p *HyperfineParser) Language() string {
+	return "hyperfine"
+}
+
+// hyperfineJSON represents the structure of hyperfine's JSON export
+type hyperfineJSON struct {
+	Results []hyperfineResult `json:"results"`
+}
+
+// hyperfineResult is one benchmarked command; times are in seconds
+type hyperfineResult struct {
+	Command    string            `json:"command"`
+	Mean       float64           `json:"mean"`
+	StdDev     *float64          `json:"stddev"` // null with a single run
+	Median     float64           `json:"median"`
+	User       float64           `json:"user"`
+	System     float64           `json:"system"`
+	Min        float64           `json:"min"`
+	Max        float64           `json:"max"`
+	Times      []float64         `json:"times"`
+	ExitCodes  []*int            `json:"exit_codes"` // null when terminated by a signal
+	Parameters map[string]string `json:"parameters"`
+}
+
+// Parse parses hyperfine JSON output.
+// Each command becomes a result; commands of a parameter sweep are named after
+// the command template and their parameter values.
+func (p *HyperfineParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data hyperfineJSON
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "hyperfine",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	for i, run := range data.Results {
+		if run.Command == "" {
+			return nil, &ParseError{
+				Line:    i + 1,
+				Message: "result has no command",
+			}
+		}
+		if run.Mean < 0 {
+			return nil, &ParseError{
+				Line:    i + 1,
+				Message: fmt.Sprintf("invalid mean time: %f", run.Mean),
+				Input:   run.Command,
+			}
+		}
+		suite.Results = append(suite.Results, run.result())
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// result converts one hyperfine command into a BenchmarkResult
+func (r *hyperfineResult) result() *BenchmarkResult {
+	result := &BenchmarkResult{
+		Name:       r.name(),
+		Language:   "hyperfine",
+		Time:       secondsToDuration(r.Mean),
+		Iterations: int64(len(r.Times)),
+		Metadata: map[string]string{
+			"framework":      "hyperfine",
+			"command":        r.Command,
+			MetadataMedianNs: formatNanos(r.Median * 1e9),
+			MetadataMinNs:    formatNanos(r.Min * 1e9),
+			MetadataMaxNs:    formatNanos(r.Max * 1e9),
+		},
+	}
+	if r.StdDev != nil {
+		result.StdDev = secondsToDuration(*r.StdDev)
+	}
+
+	for _, sec := range r.Times {
+		result.Samples = append(result.Samples, secondsToDuration(sec))
+	}
+
+	// CPU time spent in user and kernel mode, averaged over the runs
+	result.Metrics = []Metric{
+		{Name: "user_time", Unit: "s", Value: r.User},
+		{Name: "system_time", Unit: "s", Value: r.System},
+	}
+
+	if len(r.ExitCodes) > 0 {
+		distinct := make(map[string]bool)
+		failed := 0
+		for _, code := range r.ExitCodes {
+			if code == nil {
+				distinct["signal"] = true
+				failed++
+				continue
+			}
+			distinct[strconv.Itoa(*code)] = true
+			if *code != 0 {
+				failed++
+			}
+		}
+		codes := make([]string, 0, len(distinct))
+		for code := range distinct {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		result.Metadata["exit_codes"] = strings.Join(codes, ",")
+		result.Metadata["failed_runs"] = strconv.Itoa(failed)
+	}
+
+	if len(r.Parameters) > 0 {
+		result.Metadata[MetadataGroup] = r.template()
+		for name, value := range r.Parameters {
+			result.Metadata[MetadataParamPrefix+name] = value
+		}
+	}
+
+	return result
+}
+
+// name returns the command, or for a parameter sweep the command template with the
+// parameter values, e.g. "make -j {threads} [threads=4]"
+func (r *hyperfineResult) name() string {
+	if len(r.Parameters) == 0 {
+		return r.Command
+	}
+
+	keys := r.parameterNames()
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, key+"="+r.Parameters[key])
+	}
+	return r.template() + " [" + strings.Join(params, ",") + "]"
+}
+
+// template replaces the parameter values in the command with "{name}" placeholders,
+// so every command of a sweep shares it. Longer values take precedence.
+func (r *hyperfineResult) template() string {
+	keys := r.parameterNames()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return len(r.Parameters[keys[i]]) > len(r.Parameters[keys[j]])
+	})
+
+	var pairs []string
+	for _, key := range keys {
+		if value := r.Parameters[key]; value != "" {
+			pairs = append(pairs, value, "{"+key+"}")
+		}
+	}
+	return strings.NewReplacer(pairs...).Replace(r.Command)
+}
+
+// parameterNames returns the sorted parameter names
+func (r *hyperfineResult) parameterNames() []string {
+	keys := make([]string, 0, len(r.Parameters))
+	for key := range r.Parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// secondsToDuration converts seconds to a duration rounded to the nanosecond
+func secondsToDuration(sec float64) time.Duration {
+	return time.Duration(math.Round(sec * 1e9))
+}
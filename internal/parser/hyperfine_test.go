@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestHyperfineParser_Language(t *testing.T) {
+	if got := NewHyperfineParser().Language(); got != "hyperfine" {
+		t.Errorf("Language() = %v, want hyperfine", got)
+	}
+}
+
+func TestHyperfineParser_Parse(t *testing.T) {
+	input := []byte(`{
+  "results": [
+    {
+      "command": "sleep 0.1",
+      "mean": 0.1023,
+      "stddev": 0.0011,
+      "median": 0.1021,
+      "user": 0.0008,
+      "system": 0.0012,
+      "min": 0.1012,
+      "max": 0.1036,
+      "times": [0.1012, 0.1021, 0.1036],
+      "exit_codes": [0, 0, 0]
+    },
+    {
+      "command": "./fast",
+      "mean": 0.005,
+      "stddev": null,
+      "median": 0.005,
+      "user": 0.001,
+      "system": 0.002,
+      "min": 0.005,
+      "max": 0.005,
+      "times": [0.005],
+      "exit_codes": [0]
+    }
+  ]
+}`)
+
+	suite, err := NewHyperfineParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+
+	sleep := suite.Results[0]
+	if sleep.Name != "sleep 0.1" || sleep.Language != "hyperfine" {
+		t.Errorf("Results[0] = %q (%s)", sleep.Name, sleep.Language)
+	}
+	if sleep.Time != 102300*time.Microsecond || sleep.StdDev != 1100*time.Microsecond || sleep.Iterations != 3 {
+		t.Errorf("Results[0] = %v ± %v over %d", sleep.Time, sleep.StdDev, sleep.Iterations)
+	}
+	wantSamples := []time.Duration{101200 * time.Microsecond, 102100 * time.Microsecond, 103600 * time.Microsecond}
+	for i, want := range wantSamples {
+		if sleep.Samples[i] != want {
+			t.Errorf("Samples[%d] = %v, want %v", i, sleep.Samples[i], want)
+		}
+	}
+	wantMetadata := map[string]string{
+		"command":     "sleep 0.1",
+		"median_ns":   "102100000",
+		"min_ns":      "101200000",
+		"max_ns":      "103600000",
+		"exit_codes":  "0",
+		"failed_runs": "0",
+	}
+	for key, want := range wantMetadata {
+		if got := sleep.Metadata[key]; got != want {
+			t.Errorf("Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+	if len(sleep.Metrics) != 2 || sleep.Metrics[0].Name != "user_time" || sleep.Metrics[0].Value != 0.0008 ||
+		sleep.Metrics[1].Name != "system_time" || sleep.Metrics[1].Value != 0.0012 {
+		t.Errorf("Metrics = %+v, want user_time and system_time", sleep.Metrics)
+	}
+
+	// A single run has no standard deviation
+	if fast := suite.Results[1]; fast.StdDev != 0 || fast.Time != 5*time.Millisecond {
+		t.Errorf("Results[1] = %v ± %v", fast.Time, fast.StdDev)
+	}
+}
+
+func TestHyperfineParser_ParameterSweep(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/hyperfine/parameter_sweep.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+
+	suite, err := NewHyperfineParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+
+	tests := []struct {
+		name       string
+		threads    string
+		exitCodes  string
+		failedRuns string
+	}{
+		{"make -j {threads} -C build [threads=1]", "1", "0", "0"},
+		{"make -j {threads} -C build [threads=12]", "12", "0,2,signal", "2"},
+	}
+	for i, tt := range tests {
+		result := suite.Results[i]
+		if result.Name != tt.name {
+			t.Errorf("Results[%d].Name = %q, want %q", i, result.Name, tt.name)
+		}
+		if result.Metadata["group"] != "make -j {threads} -C build" {
+			t.Errorf("Results[%d].Metadata['group'] = %q", i, result.Metadata["group"])
+		}
+		if result.Metadata["param.threads"] != tt.threads {
+			t.Errorf("Results[%d].Metadata['param.threads'] = %q, want %q", i, result.Metadata["param.threads"], tt.threads)
+		}
+		if result.Metadata["exit_codes"] != tt.exitCodes || result.Metadata["failed_runs"] != tt.failedRuns {
+			t.Errorf("Results[%d] exit codes = %q (%q failed), want %q (%q failed)", i,
+				result.Metadata["exit_codes"], result.Metadata["failed_runs"], tt.exitCodes, tt.failedRuns)
+		}
+	}
+}
+
+func TestHyperfineParser_TemplateWithOverlappingValues(t *testing.T) {
+	r := &hyperfineResult{
+		Command:    "./bench --size 100 --repeat 10",
+		Parameters: map[string]string{"size": "100", "repeat": "10"},
+	}
+	if got, want := r.name(), "./bench --size {size} --repeat {repeat} [repeat=10,size=100]"; got != want {
+		t.Errorf("name() = %q, want %q", got, want)
+	}
+}
+
+func TestHyperfineParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "invalid JSON", input: "not json"},
+		{name: "no results", input: `{"results": []}`},
+		{name: "missing command", input: `{"results": [{"mean": 1.0, "times": [1.0]}]}`},
+		{name: "negative mean", input: `{"results": [{"command": "x", "mean": -1.0}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewHyperfineParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IaiCallgrindParser implements Parser for iai-callgrind output.
+// iai-callgrind runs each benchmark once under Valgrind's Callgrind, so its
+// counts are deterministic: results carry metrics but no time.
+type IaiCallgrindParser struct{}
+
+// NewIaiCallgrindParser creates a new iai-callgrind benchmark parser
+func NewIaiCallgrindParser() *IaiCallgrindParser {
+	return &IaiCallgrindParser{}
+}
+
+// Language returns the language this parser supports
+func (p *IaiCallgrindParser) Language() string {
+	return "rust"
+}
+
+// iaiMetricRegex matches an indented event line, e.g. "  Instructions:     1734|1730     (+0.23121%)".
+// The value after "|" is the previous run's count (or N/A) and is ignored.
+var iaiMetricRegex = regexp.MustCompile(`^\s+([A-Za-z0-9+ ]+):\s+(\d+)(?:\|\S+)?(?:\s.*)?$`)
+
+// iaiMetricNames maps iai-callgrind event labels to metric names and units
+var iaiMetricNames = map[string]struct{ name, unit string }{
+	"Instructions":     {MetricInstructions, "instr"},
+	"L1 Hits":          {"l1_hits", "hits"},
+	"L2 Hits":          {"ll_hits", "hits"},
+	"LL Hits":          {"ll_hits", "hits"},
+	"RAM Hits":         {"ram_hits", "hits"},
+	"L1 Accesses":      {"l1_accesses", "accesses"},
+	"L2 Accesses":      {"ll_accesses", "accesses"},
+	"RAM Accesses":     {"ram_accesses", "accesses"},
+	"Total read+write": {"total_read_write", "accesses"},
+	"Estimated Cycles": {"estimated_cycles", "cycles"},
+}
+
+// Parse parses iai-callgrind output.
+// Expected format:
+//
+//	lib_bench::bench_group::bench_fib short:10
+//	  Instructions:                1734|1734            (No change)
+//	  L1 Hits:                     2359|2359            (No change)
+//	  LL Hits:                        0|0               (No change)
+//	  RAM Hits:                       3|3               (No change)
+//	  Total read+write:            2362|2362            (No change)
+//	  Estimated Cycles:            2464|2464            (No change)
+//
+// Each benchmark becomes a result named after its header line, with one
+// lower-is-better metric per event (instructions, l1_hits, ll_hits, ram_hits,
+// total_read_write, estimated_cycles). Older versions' "Accesses" lines are
+// kept as l1_accesses, ll_accesses and ram_accesses.
+func (p *IaiCallgrindParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	suite := &BenchmarkSuite{
+		Language:  "rust",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	lineNum := 0
+
+	header := ""                 // last unindented line, the name of the benchmark that follows
+	var current *BenchmarkResult // result the metric lines belong to
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimRight(scanner.Text(), " \t")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if line[0] != ' ' && line[0] != '\t' {
+			header = strings.TrimSpace(line)
+			current = nil
+			continue
+		}
+
+		matches := iaiMetricRegex.FindStringSubmatch(line)
+		if matches == nil || header == "" {
+			continue
+		}
+		metric, ok := iaiMetricNames[strings.TrimSpace(matches[1])]
+		if !ok {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(matches[2], 64)
+		if err != nil {
+			return nil, &ParseError{
+				Line:    lineNum,
+				Message: fmt.Sprintf("failed to parse %s: %v", matches[1], err),
+				Input:   line,
+			}
+		}
+
+		if current == nil {
+			current = iaiResult(header)
+			suite.Results = append(suite.Results, current)
+		}
+		// Callgrind simulates the CPU, so event counts don't vary between runs
+		current.Metrics = append(current.Metrics, Metric{Name: metric.name, Unit: metric.unit, Value: value, Deterministic: true})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %w", err)
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// iaiResult creates a result for a header line such as "lib_bench::group::bench_fib short:10".
+// The benchmark path and its id (with arguments) are kept in metadata.
+func iaiResult(header string) *BenchmarkResult {
+	result := &BenchmarkResult{
+		Name:       header,
+		Language:   "rust",
+		Iterations: 1,
+		Metadata:   map[string]string{"framework": "iai-callgrind"},
+	}
+
+	function, id, _ := strings.Cut(header, " ")
+	result.Metadata["function"] = function
+	if id != "" {
+		result.Metadata["id"] = id
+	}
+
+	return result
+}
@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestIaiCallgrindParser_Language(t *testing.T) {
+	if got := NewIaiCallgrindParser().Language(); got != "rust" {
+		t.Errorf("Language() = %v, want rust", got)
+	}
+}
+
+func TestIaiCallgrindParser_Parse(t *testing.T) {
+	input := []byte(`     Running benches/fib.rs (target/release/deps/fib-1a2b3c)
+lib_bench::bench_group::bench_fib short:10
+  Instructions:                1734|1730            (+0.23121%) [+1.00231x]
+  L1 Hits:                     2359|2359            (No change)
+  LL Hits:                        0|0               (No change)
+  RAM Hits:                       3|3               (No change)
+  Total read+write:            2362|2362            (No change)
+  Estimated Cycles:            2464|2464            (No change)
+lib_bench::bench_group::bench_fib long:30
+  Instructions:            26214734|N/A             (*********)
+  L1 Hits:                 35638616|N/A             (*********)
+  LL Hits:                        2|N/A             (*********)
+  RAM Hits:                       4|N/A             (*********)
+  Total read+write:        35638622|N/A             (*********)
+  Estimated Cycles:        35638766|N/A             (*********)
+old_bench::bench_sum
+  Instructions:                 512
+  L1 Accesses:                  700
+  L2 Accesses:                    1
+  RAM Accesses:                   2
+  Estimated Cycles:             775
+`)
+
+	suite, err := NewIaiCallgrindParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(suite.Results))
+	}
+
+	short := suite.Results[0]
+	if short.Name != "lib_bench::bench_group::bench_fib short:10" || short.Language != "rust" {
+		t.Errorf("Results[0] = %q (%s)", short.Name, short.Language)
+	}
+	if short.Time != 0 || short.Samples != nil {
+		t.Errorf("Results[0] has time %v and samples %v, want none", short.Time, short.Samples)
+	}
+	if short.Metadata["function"] != "lib_bench::bench_group::bench_fib" || short.Metadata["id"] != "short:10" {
+		t.Errorf("Results[0].Metadata = %v", short.Metadata)
+	}
+
+	want := []Metric{
+		{Name: "instructions", Unit: "instr", Value: 1734},
+		{Name: "l1_hits", Unit: "hits", Value: 2359},
+		{Name: "ll_hits", Unit: "hits", Value: 0},
+		{Name: "ram_hits", Unit: "hits", Value: 3},
+		{Name: "total_read_write", Unit: "accesses", Value: 2362},
+		{Name: "estimated_cycles", Unit: "cycles", Value: 2464},
+	}
+	if len(short.Metrics) != len(want) {
+		t.Fatalf("len(Metrics) = %d, want %d", len(short.Metrics), len(want))
+	}
+	for i, w := range want {
+		m := short.Metrics[i]
+		if m.Name != w.Name || m.Unit != w.Unit || m.Value != w.Value || m.HigherIsBetter {
+			t.Errorf("Metrics[%d] = %+v, want %+v", i, m, w)
+		}
+	}
+
+	if v := suite.Results[1].Metrics[0].Value; v != 26214734 {
+		t.Errorf("Results[1] instructions = %v, want 26214734", v)
+	}
+
+	old := suite.Results[2]
+	if len(old.Metrics) != 5 || old.Metrics[1].Name != "l1_accesses" || old.Metrics[2].Name != "ll_accesses" {
+		t.Errorf("Results[2].Metrics = %+v, want accesses from older output", old.Metrics)
+	}
+	if _, ok := old.Metadata["id"]; ok {
+		t.Errorf("Results[2].Metadata = %v, want no id", old.Metadata)
+	}
+}
+
+func TestIaiCallgrindParser_Parse_NoResults(t *testing.T) {
+	input := []byte(`   Compiling fib v0.1.0
+    Finished bench [optimized] target(s) in 2.31s
+`)
+
+	if _, err := NewIaiCallgrindParser().Parse(input); err == nil {
+		t.Error("Parse() error = nil, want error")
+	}
+}
@@ -0,0 +1,299 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JMHParser implements Parser for JMH (Java Microbenchmark Harness) JSON results
+// written with `-rf json`
+type JMHParser struct{}
+
+// NewJMHParser creates a new JMH benchmark parser
+func NewJMHParser() *JMHParser {
+	return &JMHParser{}
+}
+
+// Language returns the language this parser supports
+func (p *JMHParser) Language() string {
+	return "java"
+}
+
+// jmhBenchmark represents one entry of the JMH JSON results array
+type jmhBenchmark struct {
+	JMHVersion       string               `json:"jmhVersion"`
+	Benchmark        string               `json:"benchmark"`
+	Mode             string               `json:"mode"` // "thrpt", "avgt", "sample" or "ss"
+	Threads          int                  `json:"threads"`
+	Forks            int                  `json:"forks"`
+	JDKVersion       string               `json:"jdkVersion"`
+	VMName           string               `json:"vmName"`
+	VMVersion        string               `json:"vmVersion"`
+	Params           map[string]string    `json:"params"`
+	PrimaryMetric    *jmhMetric           `json:"primaryMetric"`
+	SecondaryMetrics map[string]jmhMetric `json:"secondaryMetrics"`
+}
+
+// jmhMetric is a JMH score with its error, confidence interval and raw measurements
+type jmhMetric struct {
+	Score           jmhFloat     `json:"score"`
+	ScoreError      jmhFloat     `json:"scoreError"`
+	ScoreConfidence []jmhFloat   `json:"scoreConfidence"`
+	ScoreUnit       string       `json:"scoreUnit"`
+	RawData         [][]jmhFloat `json:"rawData"` // One slice of iteration scores per fork
+}
+
+// jmhFloat is a number JMH may write as the string "NaN" (e.g. the score error of a single iteration)
+type jmhFloat float64
+
+// UnmarshalJSON accepts both JSON numbers and quoted numbers such as "NaN" and "Infinity"
+func (f *jmhFloat) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %s", data)
+	}
+	*f = jmhFloat(v)
+	return nil
+}
+
+// jmhTimeUnits maps the time unit of a JMH score to nanoseconds
+var jmhTimeUnits = map[string]float64{
+	"ns":  1,
+	"us":  1e3,
+	"ms":  1e6,
+	"s":   1e9,
+	"min": 60e9,
+	"hr":  3600e9,
+	"day": 86400e9,
+}
+
+// Parse parses JMH JSON results.
+// Each benchmark and parameter combination becomes a result; time-per-operation
+// scores (ns/op, us/op, ...) become the time, throughput scores (ops/s, ops/ms, ...)
+// become ops/s throughput with the time derived from it.
+func (p *JMHParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data []jmhBenchmark
+	if err := json.Unmarshal(jmhJSON(output), &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "java",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	for i, bench := range data {
+		// A benchmark without a finite score (e.g. "NaN" from a failed run) has no measurement
+		if bench.PrimaryMetric == nil || !jmhIsFinite(bench.PrimaryMetric.Score) {
+			continue
+		}
+
+		result, err := bench.result()
+		if err != nil {
+			return nil, &ParseError{
+				Line:    i + 1,
+				Message: err.Error(),
+				Input:   bench.Benchmark,
+			}
+		}
+		suite.Results = append(suite.Results, result)
+
+		for key, value := range map[string]string{
+			"jmh_version": bench.JMHVersion,
+			"jdk_version": bench.JDKVersion,
+			"vm_name":     bench.VMName,
+			"vm_version":  bench.VMVersion,
+		} {
+			if value != "" && suite.Metadata[key] == "" {
+				suite.Metadata[key] = value
+			}
+		}
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// jmhJSON returns the JSON array in the output, skipping the console log JMH
+// prints before it when the results file is written to stdout
+func jmhJSON(output []byte) []byte {
+	trimmed := bytes.TrimSpace(output)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return trimmed
+	}
+
+	// JMH starts the array on a line of its own; "[INFO]" lines from Maven are skipped
+	offset := 0
+	for _, line := range bytes.SplitAfter(output, []byte("\n")) {
+		trimmed := bytes.TrimSpace(line)
+		if bytes.Equal(trimmed, []byte("[")) || bytes.HasPrefix(trimmed, []byte("[{")) {
+			return output[offset:]
+		}
+		offset += len(line)
+	}
+	return output
+}
+
+// result converts one JMH benchmark entry into a BenchmarkResult
+func (b *jmhBenchmark) result() (*BenchmarkResult, error) {
+	primary := b.PrimaryMetric
+	perOp, nanos, err := parseJMHUnit(primary.ScoreUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	// toNanos converts a score to nanoseconds per operation
+	toNanos := func(score float64) float64 {
+		if perOp {
+			return score * nanos
+		}
+		if score <= 0 {
+			return 0
+		}
+		return nanos / score
+	}
+
+	score := float64(primary.Score)
+	result := &BenchmarkResult{
+		Name:     b.name(),
+		Language: "java",
+		Time:     time.Duration(math.Round(toNanos(score))),
+		Metadata: map[string]string{
+			"framework":   "jmh",
+			"benchmark":   b.Benchmark,
+			"mode":        b.Mode,
+			"score":       strconv.FormatFloat(score, 'f', -1, 64),
+			"score_unit":  primary.ScoreUnit,
+			"score_error": strconv.FormatFloat(jmhFinite(primary.ScoreError), 'f', -1, 64),
+		},
+	}
+
+	if !perOp && score > 0 {
+		result.Throughput = &Throughput{Value: score / nanos * 1e9, Unit: "ops/s"}
+	}
+
+	// Each sample is one measurement iteration of one fork; JMH doesn't report
+	// the number of operations behind it
+	for _, iterations := range primary.RawData {
+		for _, sample := range iterations {
+			if jmhIsFinite(sample) {
+				result.Samples = append(result.Samples, time.Duration(math.Round(toNanos(float64(sample)))))
+			}
+		}
+	}
+	result.SampleCount = int64(len(result.Samples))
+	if len(result.Samples) > 1 {
+		_, _, result.StdDev = summarizeSamples(result.Samples)
+	}
+
+	if len(primary.ScoreConfidence) == 2 && !math.IsNaN(float64(primary.ScoreConfidence[0])) {
+		result.Metadata["score_confidence_lower"] = strconv.FormatFloat(float64(primary.ScoreConfidence[0]), 'f', -1, 64)
+		result.Metadata["score_confidence_upper"] = strconv.FormatFloat(float64(primary.ScoreConfidence[1]), 'f', -1, 64)
+	}
+	if b.Forks > 0 {
+		result.Metadata["forks"] = strconv.Itoa(b.Forks)
+	}
+	if b.Threads > 0 {
+		result.Metadata["threads"] = strconv.Itoa(b.Threads)
+	}
+	for name, value := range b.Params {
+		result.Metadata[MetadataParamPrefix+name] = value
+	}
+
+	// Secondary metrics from profilers (e.g. -prof gc), sorted for a stable order
+	names := make([]string, 0, len(b.SecondaryMetrics))
+	for name := range b.SecondaryMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		secondary := b.SecondaryMetrics[name]
+		if !jmhIsFinite(secondary.Score) {
+			continue
+		}
+		metric := Metric{
+			Name:           strings.TrimPrefix(name, "·"),
+			Unit:           secondary.ScoreUnit,
+			Value:          float64(secondary.Score),
+			HigherIsBetter: strings.HasPrefix(secondary.ScoreUnit, "ops/"),
+		}
+		for _, iterations := range secondary.RawData {
+			for _, sample := range iterations {
+				if jmhIsFinite(sample) {
+					metric.Samples = append(metric.Samples, float64(sample))
+				}
+			}
+		}
+		result.Metrics = append(result.Metrics, metric)
+	}
+
+	return result, nil
+}
+
+// name returns the benchmark name with its parameters, e.g. "com.example.Sort.quick:size=1000"
+func (b *jmhBenchmark) name() string {
+	if len(b.Params) == 0 {
+		return b.Benchmark
+	}
+
+	keys := make([]string, 0, len(b.Params))
+	for key := range b.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, key+"="+b.Params[key])
+	}
+	return b.Benchmark + ":" + strings.Join(params, ",")
+}
+
+// parseJMHUnit parses a JMH score unit. It reports whether the score is a time per
+// operation ("us/op") rather than a throughput ("ops/ms"), and the unit in nanoseconds.
+func parseJMHUnit(unit string) (perOp bool, nanos float64, err error) {
+	if timeUnit, ok := strings.CutSuffix(unit, "/op"); ok {
+		if nanos, ok := jmhTimeUnits[timeUnit]; ok {
+			return true, nanos, nil
+		}
+	}
+	if timeUnit, ok := strings.CutPrefix(unit, "ops/"); ok {
+		if nanos, ok := jmhTimeUnits[timeUnit]; ok {
+			return false, nanos, nil
+		}
+	}
+	return false, 0, fmt.Errorf("unsupported score unit %q", unit)
+}
+
+// jmhFinite returns 0 for the NaN scoreError JMH reports with a single iteration
+func jmhFinite(v jmhFloat) float64 {
+	if !jmhIsFinite(v) {
+		return 0
+	}
+	return float64(v)
+}
+
+// jmhIsFinite reports whether v is neither NaN nor infinite
+func jmhIsFinite(v jmhFloat) bool {
+	return !math.IsNaN(float64(v)) && !math.IsInf(float64(v), 0)
+}
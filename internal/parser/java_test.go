@@ -0,0 +1,200 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestJMHParser_Language(t *testing.T) {
+	if got := NewJMHParser().Language(); got != "java" {
+		t.Errorf("Language() = %v, want java", got)
+	}
+}
+
+func TestJMHParser_Results(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/java/jmh_results.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+
+	suite, err := NewJMHParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	if suite.Metadata["jmh_version"] != "1.37" || suite.Metadata["jdk_version"] != "17.0.9" || suite.Metadata["vm_name"] != "OpenJDK 64-Bit Server VM" {
+		t.Errorf("suite.Metadata = %v", suite.Metadata)
+	}
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(suite.Results))
+	}
+
+	// Average time in us/op, two forks of three iterations
+	small := suite.Results[0]
+	if small.Name != "com.example.SortBenchmark.quickSort:distribution=random,size=1000" || small.Language != "java" {
+		t.Errorf("Results[0] = %q (%s)", small.Name, small.Language)
+	}
+	if small.Time != 12500*time.Nanosecond {
+		t.Errorf("Time = %v, want 12.5µs", small.Time)
+	}
+	if small.Throughput != nil {
+		t.Errorf("Throughput = %+v, want nil for avgt", small.Throughput)
+	}
+	if len(small.Samples) != 6 || small.Samples[0] != 12*time.Microsecond || small.Samples[5] != 12750*time.Nanosecond {
+		t.Errorf("Samples = %v, want 6 per-iteration times", small.Samples)
+	}
+	if small.SampleCount != 6 || small.Iterations != 0 || small.StdDev == 0 {
+		t.Errorf("SampleCount = %d, Iterations = %d, StdDev = %v", small.SampleCount, small.Iterations, small.StdDev)
+	}
+	wantMetadata := map[string]string{
+		"framework":              "jmh",
+		"mode":                   "avgt",
+		"score_unit":             "us/op",
+		"score_error":            "0.8",
+		"score_confidence_lower": "11.7",
+		"score_confidence_upper": "13.3",
+		"forks":                  "2",
+		"param.size":             "1000",
+		"param.distribution":     "random",
+	}
+	for key, want := range wantMetadata {
+		if got := small.Metadata[key]; got != want {
+			t.Errorf("Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+	if len(small.Metrics) != 2 {
+		t.Fatalf("Metrics = %+v, want gc.alloc.rate and gc.alloc.rate.norm", small.Metrics)
+	}
+	if m := small.Metrics[0]; m.Name != "gc.alloc.rate" || m.Unit != "MB/sec" || m.Value != 310.5 || len(m.Samples) != 6 || m.HigherIsBetter {
+		t.Errorf("Metrics[0] = %+v", m)
+	}
+	if m := small.Metrics[1]; m.Name != "gc.alloc.rate.norm" || m.Unit != "B/op" || m.Value != 4096 {
+		t.Errorf("Metrics[1] = %+v", m)
+	}
+
+	// A single iteration reports its error as "NaN"
+	large := suite.Results[1]
+	if large.Time != 150*time.Microsecond || large.StdDev != 0 || large.Metadata["score_error"] != "0" {
+		t.Errorf("Results[1] = %v ± %v, metadata %v", large.Time, large.StdDev, large.Metadata)
+	}
+	if _, ok := large.Metadata["score_confidence_lower"]; ok {
+		t.Errorf("Results[1] has a NaN confidence interval in metadata: %v", large.Metadata)
+	}
+
+	// Throughput in ops/ms: higher is better, time is derived
+	lookup := suite.Results[2]
+	if lookup.Name != "com.example.CacheBenchmark.lookup" {
+		t.Errorf("Results[2].Name = %q", lookup.Name)
+	}
+	if lookup.Throughput == nil || lookup.Throughput.Unit != "ops/s" || lookup.Throughput.Value != 2e6 {
+		t.Errorf("Throughput = %+v, want 2e6 ops/s", lookup.Throughput)
+	}
+	if lookup.Time != 500*time.Nanosecond {
+		t.Errorf("Time = %v, want 500ns", lookup.Time)
+	}
+	wantSamples := []time.Duration{1000 * time.Nanosecond, 500 * time.Nanosecond, 250 * time.Nanosecond}
+	for i, want := range wantSamples {
+		if lookup.Samples[i] != want {
+			t.Errorf("Samples[%d] = %v, want %v", i, lookup.Samples[i], want)
+		}
+	}
+}
+
+func TestJMHParser_ScoreUnits(t *testing.T) {
+	tests := []struct {
+		unit       string
+		score      string
+		wantTime   time.Duration
+		throughput float64
+	}{
+		{unit: "ns/op", score: "42.4", wantTime: 42 * time.Nanosecond},
+		{unit: "ms/op", score: "1.5", wantTime: 1500 * time.Microsecond},
+		{unit: "s/op", score: "2", wantTime: 2 * time.Second},
+		{unit: "ops/s", score: "1000", wantTime: time.Millisecond, throughput: 1000},
+		{unit: "ops/us", score: "4", wantTime: 250 * time.Nanosecond, throughput: 4e6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unit, func(t *testing.T) {
+			input := []byte(`[{"benchmark": "b.B.m", "mode": "avgt", "primaryMetric": {"score": ` + tt.score + `, "scoreUnit": "` + tt.unit + `", "rawData": []}}]`)
+			suite, err := NewJMHParser().Parse(input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			result := suite.Results[0]
+			if result.Time != tt.wantTime {
+				t.Errorf("Time = %v, want %v", result.Time, tt.wantTime)
+			}
+			if tt.throughput == 0 && result.Throughput != nil {
+				t.Errorf("Throughput = %+v, want nil", result.Throughput)
+			}
+			if tt.throughput != 0 && (result.Throughput == nil || result.Throughput.Value != tt.throughput) {
+				t.Errorf("Throughput = %+v, want %v ops/s", result.Throughput, tt.throughput)
+			}
+		})
+	}
+}
+
+func TestJMHParser_ConsoleLogBeforeJSON(t *testing.T) {
+	input := []byte(`# JMH version: 1.37
+[INFO] Building benchmarks 1.0
+# Benchmark: com.example.Fib.fib
+Result "com.example.Fib.fib":
+  3.2 ±(99.9%) 0.1 ns/op [Average]
+[
+  {"benchmark": "com.example.Fib.fib", "mode": "avgt", "primaryMetric": {"score": 3.2, "scoreUnit": "ns/op", "rawData": [[3.1, 3.3]]}}
+]
+`)
+
+	suite, err := NewJMHParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 1 || suite.Results[0].Name != "com.example.Fib.fib" {
+		t.Errorf("Results = %+v", suite.Results)
+	}
+}
+
+func TestJMHParser_NaNScores(t *testing.T) {
+	input := []byte(`[
+  {"benchmark": "com.example.Fib.broken", "mode": "avgt", "primaryMetric": {"score": "NaN", "scoreError": "NaN", "scoreUnit": "ns/op", "rawData": [["NaN"]]}},
+  {"benchmark": "com.example.Fib.fib", "mode": "avgt",
+   "primaryMetric": {"score": 3.2, "scoreUnit": "ns/op", "rawData": [[3.1, "NaN", 3.3]]},
+   "secondaryMetrics": {"·gc.alloc.rate": {"score": "NaN", "scoreUnit": "MB/sec", "rawData": [["NaN"]]}}}
+]`)
+
+	suite, err := NewJMHParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1 (NaN score skipped)", len(suite.Results))
+	}
+	result := suite.Results[0]
+	if result.Name != "com.example.Fib.fib" || result.SampleCount != 2 {
+		t.Errorf("Name = %q, SampleCount = %d, want com.example.Fib.fib with 2 samples", result.Name, result.SampleCount)
+	}
+	if len(result.Metrics) != 0 {
+		t.Errorf("Metrics = %+v, want NaN secondary metric skipped", result.Metrics)
+	}
+}
+
+func TestJMHParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "invalid JSON", input: "not json"},
+		{name: "empty array", input: "[]"},
+		{name: "unknown unit", input: `[{"benchmark": "b.B.m", "primaryMetric": {"score": 1, "scoreUnit": "furlongs/op"}}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewJMHParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// MitataParser implements Parser for mitata JSON output (`run({ format: 'json' })`,
+// or `run({ json: true })` before mitata 1.0)
+type MitataParser struct{}
+
+// NewMitataParser creates a new mitata parser
+func NewMitataParser() *MitataParser {
+	return &MitataParser{}
+}
+
+// Language returns the language this parser supports
+func (p *MitataParser) Language() string {
+	return "nodejs"
+}
+
+// mitataJSON represents mitata's JSON output. Since 1.0 the environment is in
+// context and benchmark groups in layout; older versions report cpu and runtime
+// at the top level.
+type mitataJSON struct {
+	Context    *mitataContext    `json:"context"`
+	Layout     []mitataLayout    `json:"layout"`
+	Benchmarks []mitataBenchmark `json:"benchmarks"`
+	CPU        string            `json:"cpu"`
+	Runtime    string            `json:"runtime"`
+}
+
+// mitataContext describes the machine and runtime
+type mitataContext struct {
+	Arch    string `json:"arch"`
+	Runtime string `json:"runtime"`
+	Version string `json:"version"`
+	CPU     struct {
+		Name string  `json:"name"`
+		Freq float64 `json:"freq"` // GHz
+	} `json:"cpu"`
+}
+
+// mitataLayout is a group() block; the first entry is the implicit top-level group
+type mitataLayout struct {
+	Name *string `json:"name"`
+}
+
+// mitataBenchmark is one bench() trial. Since 1.0 it has one run per argument
+// combination; older versions report the statistics on the benchmark itself.
+type mitataBenchmark struct {
+	Alias    string          `json:"alias"`
+	Name     string          `json:"name"`
+	Group    json.RawMessage `json:"group"` // Layout index, or the group name before 1.0
+	Baseline bool            `json:"baseline"`
+	Runs     []mitataRun     `json:"runs"`
+	Stats    *mitataStats    `json:"stats"`
+	Error    json.RawMessage `json:"error"`
+}
+
+// mitataRun is the measurement of a benchmark for one argument combination
+type mitataRun struct {
+	Name  string                 `json:"name"`
+	Args  map[string]interface{} `json:"args"`
+	Stats *mitataStats           `json:"stats"`
+	Error json.RawMessage        `json:"error"`
+}
+
+// mitataStats are the run statistics, in nanoseconds per iteration
+type mitataStats struct {
+	Min     float64   `json:"min"`
+	Max     float64   `json:"max"`
+	Avg     float64   `json:"avg"`
+	P25     float64   `json:"p25"`
+	P50     float64   `json:"p50"`
+	P75     float64   `json:"p75"`
+	P99     float64   `json:"p99"`
+	P999    float64   `json:"p999"`
+	Ticks   int64     `json:"ticks"`   // Iterations measured
+	Samples []float64 `json:"samples"` // Only kept with { samples: true }
+}
+
+// Parse parses mitata JSON output.
+// Benchmarks inside a group() are named "group > benchmark"; failed runs are skipped.
+func (p *MitataParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data mitataJSON
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "nodejs",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+	data.addMetadata(suite.Metadata)
+
+	for _, bench := range data.Benchmarks {
+		group := data.groupName(bench.Group)
+
+		runs := bench.Runs
+		if len(runs) == 0 && bench.Stats != nil {
+			// Before 1.0: a single run without arguments
+			runs = []mitataRun{{Name: bench.Name, Stats: bench.Stats, Error: bench.Error}}
+		}
+
+		for _, run := range runs {
+			if run.Stats == nil || mitataFailed(run.Error) {
+				continue
+			}
+			if run.Name == "" {
+				run.Name = bench.Alias
+			}
+			if run.Stats.Avg < 0 {
+				return nil, &ParseError{
+					Message: fmt.Sprintf("invalid average time: %f", run.Stats.Avg),
+					Input:   run.Name,
+				}
+			}
+
+			result := run.result(group)
+			if bench.Baseline {
+				result.Metadata["baseline"] = "true"
+			}
+			suite.Results = append(suite.Results, result)
+		}
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// result converts a mitata run into a BenchmarkResult
+func (r *mitataRun) result(group string) *BenchmarkResult {
+	stats := r.Stats
+
+	name := r.Name
+	if group != "" {
+		name = group + " > " + name
+	}
+
+	result := &BenchmarkResult{
+		Name:       name,
+		Language:   "nodejs",
+		Time:       time.Duration(math.Round(stats.Avg)),
+		Iterations: stats.Ticks,
+		Metadata:   map[string]string{"framework": "mitata"},
+	}
+	if stats.Avg > 0 {
+		result.Throughput = &Throughput{Value: 1e9 / stats.Avg, Unit: "ops/s"}
+	}
+	if group != "" {
+		result.Metadata[MetadataGroup] = group
+	}
+	for key, value := range r.Args {
+		result.Metadata[MetadataParamPrefix+key] = jsonScalarString(value)
+	}
+
+	for key, ns := range map[string]float64{
+		MetadataMedianNs: stats.P50,
+		MetadataMinNs:    stats.Min,
+		MetadataMaxNs:    stats.Max,
+		"q1_ns":          stats.P25,
+		"p75_ns":         stats.P75,
+		"p99_ns":         stats.P99,
+		"p999_ns":        stats.P999,
+	} {
+		if ns > 0 {
+			result.Metadata[key] = formatNanos(ns)
+		}
+	}
+
+	for _, ns := range stats.Samples {
+		result.Samples = append(result.Samples, time.Duration(math.Round(ns)))
+	}
+	if len(result.Samples) > 1 {
+		_, _, result.StdDev = summarizeSamples(result.Samples)
+		result.Metadata["rme"] = strconv.FormatFloat(relativeMarginOfError(result.Samples), 'f', -1, 64)
+	}
+	if result.Iterations == 0 {
+		result.Iterations = int64(len(result.Samples))
+	}
+
+	return result
+}
+
+// groupName returns the name of a benchmark's group: a layout index since 1.0,
+// a name (or null) before
+func (d *mitataJSON) groupName(group json.RawMessage) string {
+	var index int
+	if err := json.Unmarshal(group, &index); err == nil {
+		if index >= 0 && index < len(d.Layout) && d.Layout[index].Name != nil {
+			return *d.Layout[index].Name
+		}
+		return ""
+	}
+
+	var name string
+	if err := json.Unmarshal(group, &name); err == nil {
+		return name
+	}
+	return ""
+}
+
+// addMetadata records the machine and runtime as suite metadata
+func (d *mitataJSON) addMetadata(metadata map[string]string) {
+	values := map[string]string{
+		MetadataCPU: d.CPU,
+		"runtime":   d.Runtime,
+	}
+	if ctx := d.Context; ctx != nil {
+		values[MetadataCPU] = ctx.CPU.Name
+		values["runtime"] = ctx.Runtime
+		values["runtime_version"] = ctx.Version
+		values["arch"] = ctx.Arch
+		if ctx.CPU.Freq > 0 {
+			values["cpu_freq_ghz"] = strconv.FormatFloat(ctx.CPU.Freq, 'f', -1, 64)
+		}
+	}
+
+	for key, value := range values {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+}
+
+// mitataFailed reports whether a run recorded an error (anything but absent, null or false)
+func mitataFailed(err json.RawMessage) bool {
+	switch string(err) {
+	case "", "null", "false":
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,145 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMitataParser_Language(t *testing.T) {
+	if got := NewMitataParser().Language(); got != "nodejs" {
+		t.Errorf("Language() = %v, want nodejs", got)
+	}
+}
+
+func TestMitataParser_Parse(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/nodejs/mitata.json")
+	if err != nil {
+		t.Fatalf("failed to read testdata: %v", err)
+	}
+
+	suite, err := NewMitataParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	// The failed sort(1000) run is skipped
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+
+	for key, want := range map[string]string{
+		MetadataCPU:       "AMD EPYC 7763 64-Core Processor",
+		"runtime":         "node",
+		"runtime_version": "20.14.0",
+		"arch":            "x86_64-linux",
+		"cpu_freq_ghz":    "3.24",
+	} {
+		if got := suite.Metadata[key]; got != want {
+			t.Errorf("Suite.Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	noop := suite.Results[0]
+	if noop.Name != "noop" || noop.Time != 0 || noop.Iterations != 4312076 {
+		t.Errorf("Results[0] = %q %v over %d", noop.Name, noop.Time, noop.Iterations)
+	}
+	if _, ok := noop.Metadata[MetadataGroup]; ok {
+		t.Errorf("Results[0].Metadata[%q] is set, want unset for the top-level group", MetadataGroup)
+	}
+	if noop.Throughput == nil || noop.Throughput.Value != 4e9 {
+		t.Errorf("Results[0].Throughput = %+v, want 4e9 ops/s", noop.Throughput)
+	}
+
+	sort := suite.Results[1]
+	if sort.Name != "sorting > sort(100)" {
+		t.Errorf("Results[1].Name = %q, want %q", sort.Name, "sorting > sort(100)")
+	}
+	if sort.Time != 2*time.Microsecond || sort.Iterations != 4 || len(sort.Samples) != 4 {
+		t.Errorf("Results[1] = %v over %d with %d samples", sort.Time, sort.Iterations, len(sort.Samples))
+	}
+	// Sample stddev of 1800, 1900, 2100, 2200 is sqrt(100000/3) ≈ 182.57ns
+	if sort.StdDev != 182*time.Nanosecond {
+		t.Errorf("Results[1].StdDev = %v, want 182ns", sort.StdDev)
+	}
+	for key, want := range map[string]string{
+		"framework":      "mitata",
+		MetadataGroup:    "sorting",
+		"param.size":     "100",
+		"baseline":       "true",
+		MetadataMedianNs: "2000",
+		MetadataMinNs:    "1800",
+		MetadataMaxNs:    "2400",
+		"q1_ns":          "1900",
+		"p75_ns":         "2100",
+		"p99_ns":         "2400",
+	} {
+		if got := sort.Metadata[key]; got != want {
+			t.Errorf("Results[1].Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+	if sort.Metadata["rme"] == "" {
+		t.Error("Results[1].Metadata missing 'rme' with samples")
+	}
+}
+
+func TestMitataParser_Parse_Legacy(t *testing.T) {
+	input := []byte(`{
+  "cpu": "Apple M1",
+  "runtime": "bun 1.0.25 (arm64-darwin)",
+  "benchmarks": [
+    {
+      "name": "Array.from",
+      "group": "arrays",
+      "time": 500,
+      "warmup": true,
+      "baseline": false,
+      "async": false,
+      "stats": {"min": 40.1, "max": 120.5, "avg": 45.2, "p75": 46.0, "p99": 80.3, "p999": 110.0}
+    },
+    {
+      "name": "throws",
+      "group": null,
+      "error": {"message": "boom"}
+    }
+  ]
+}`)
+
+	suite, err := NewMitataParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1", len(suite.Results))
+	}
+	if suite.Metadata[MetadataCPU] != "Apple M1" || suite.Metadata["runtime"] != "bun 1.0.25 (arm64-darwin)" {
+		t.Errorf("Suite.Metadata = %v", suite.Metadata)
+	}
+
+	result := suite.Results[0]
+	if result.Name != "arrays > Array.from" || result.Time != 45*time.Nanosecond {
+		t.Errorf("Results[0] = %q %v", result.Name, result.Time)
+	}
+	if result.Metadata[MetadataGroup] != "arrays" || result.Metadata["p99_ns"] != "80.3" {
+		t.Errorf("Results[0].Metadata = %v", result.Metadata)
+	}
+}
+
+func TestMitataParser_Parse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"invalid JSON", `{"benchmarks": [`},
+		{"no benchmarks", `{"benchmarks": []}`},
+		{"only failures", `{"benchmarks": [{"alias": "x", "group": 0, "runs": [{"name": "x", "error": {"message": "boom"}}]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewMitataParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// PyperfParser implements Parser for pyperf JSON results
+// (e.g. `python -m pyperf timeit -o result.json` or pyperformance)
+type PyperfParser struct{}
+
+// NewPyperfParser creates a new pyperf parser
+func NewPyperfParser() *PyperfParser {
+	return &PyperfParser{}
+}
+
+// Language returns the language this parser supports
+func (p *PyperfParser) Language() string {
+	return "python"
+}
+
+// pyperfJSON represents a pyperf benchmark suite file
+type pyperfJSON struct {
+	Version    string                 `json:"version"`
+	Metadata   map[string]interface{} `json:"metadata"` // Common to all benchmarks
+	Benchmarks []pyperfBenchmark      `json:"benchmarks"`
+}
+
+// pyperfBenchmark is one benchmark with its runs (one run per worker process)
+type pyperfBenchmark struct {
+	Metadata map[string]interface{} `json:"metadata"`
+	Runs     []pyperfRun            `json:"runs"`
+}
+
+// pyperfRun holds the warmup and measured values of one worker process.
+// Values are per loop iteration, in the benchmark's unit (seconds by default).
+type pyperfRun struct {
+	Metadata map[string]interface{} `json:"metadata"`
+	Warmups  [][]float64            `json:"warmups"` // [loops, value] pairs
+	Values   []float64              `json:"values"`
+}
+
+// Parse parses pyperf JSON output.
+// Warmup values are excluded; the values of all runs become the samples.
+func (p *PyperfParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data pyperfJSON
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "python",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	for key, value := range data.Metadata {
+		suite.Metadata[key] = jsonScalarString(value)
+	}
+	if cpu, ok := suite.Metadata["cpu_model_name"]; ok {
+		suite.Metadata[MetadataCPU] = cpu
+	}
+
+	for i, bench := range data.Benchmarks {
+		// Benchmark metadata overrides the common metadata
+		metadata := make(map[string]interface{})
+		for key, value := range data.Metadata {
+			metadata[key] = value
+		}
+		for key, value := range bench.Metadata {
+			metadata[key] = value
+		}
+
+		result, err := bench.result(metadata)
+		if err != nil {
+			return nil, &ParseError{
+				Line:    i + 1,
+				Message: err.Error(),
+				Input:   jsonScalarString(metadata["name"]),
+			}
+		}
+		if result != nil {
+			suite.Results = append(suite.Results, result)
+		}
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// result converts a pyperf benchmark into a BenchmarkResult.
+// It returns nil for benchmarks without measured values (calibration runs only).
+func (b *pyperfBenchmark) result(metadata map[string]interface{}) (*BenchmarkResult, error) {
+	name := jsonScalarString(metadata["name"])
+	if name == "" {
+		return nil, fmt.Errorf("benchmark has no name")
+	}
+
+	var values []float64
+	warmups := 0
+	for _, run := range b.Runs {
+		values = append(values, run.Values...)
+		warmups += len(run.Warmups)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	result := &BenchmarkResult{
+		Name:       name,
+		Language:   "python",
+		Iterations: int64(len(values)),
+		Metadata: map[string]string{
+			"framework": "pyperf",
+			"runs":      strconv.Itoa(len(b.Runs)),
+			"warmups":   strconv.Itoa(warmups),
+		},
+	}
+	for _, key := range []string{"loops", "inner_loops", "description"} {
+		if value, ok := metadata[key]; ok {
+			result.Metadata[key] = jsonScalarString(value)
+		}
+	}
+
+	unit := jsonScalarString(metadata["unit"])
+	switch unit {
+	case "", "second":
+		for _, sec := range values {
+			if sec < 0 {
+				return nil, fmt.Errorf("invalid value: %f", sec)
+			}
+			result.Samples = append(result.Samples, secondsToDuration(sec))
+		}
+		var median time.Duration
+		result.Time, median, result.StdDev = summarizeSamples(result.Samples)
+		result.Metadata[MetadataMedianNs] = formatNanos(float64(median))
+	case "byte":
+		// --track-memory and --tracemalloc report peak memory
+		result.Metrics = []Metric{{Name: "memory", Unit: "B", Value: meanFloat64(values), Samples: values}}
+	case "integer":
+		result.Metrics = []Metric{{Name: "value", Unit: "value", Value: meanFloat64(values), Samples: values}}
+	default:
+		return nil, fmt.Errorf("unsupported unit %q", unit)
+	}
+
+	return result, nil
+}
+
+// jsonScalarString formats a decoded JSON scalar (string, number or bool) as metadata
+func jsonScalarString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
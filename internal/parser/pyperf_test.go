@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestPyperfParser_Language(t *testing.T) {
+	if got := NewPyperfParser().Language(); got != "python" {
+		t.Errorf("Language() = %v, want python", got)
+	}
+}
+
+func TestPyperfParser_Timeit(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/python/pyperf_timeit.json")
+	if err != nil {
+		t.Skipf("Skipping test - testdata file not found: %v", err)
+	}
+
+	suite, err := NewPyperfParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	wantSuite := map[string]string{
+		"cpu":            "Intel(R) Core(TM) i7-10700 CPU @ 2.90GHz",
+		"cpu_count":      "8",
+		"hostname":       "perf-01",
+		"python_version": "3.12.2 (64-bit)",
+		"perf_version":   "2.6.2",
+	}
+	for key, want := range wantSuite {
+		if got := suite.Metadata[key]; got != want {
+			t.Errorf("suite.Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	if len(suite.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(suite.Results))
+	}
+
+	// The calibration run only has warmups; warmups never become samples
+	dumps := suite.Results[0]
+	if dumps.Name != "json_dumps" || dumps.Language != "python" {
+		t.Errorf("Results[0] = %q (%s)", dumps.Name, dumps.Language)
+	}
+	wantSamples := []time.Duration{10 * time.Microsecond, 12 * time.Microsecond, 11 * time.Microsecond,
+		11 * time.Microsecond, 10 * time.Microsecond, 12 * time.Microsecond}
+	if len(dumps.Samples) != len(wantSamples) {
+		t.Fatalf("Samples = %v, want %v", dumps.Samples, wantSamples)
+	}
+	for i, want := range wantSamples {
+		if dumps.Samples[i] != want {
+			t.Errorf("Samples[%d] = %v, want %v", i, dumps.Samples[i], want)
+		}
+	}
+	if dumps.Time != 11*time.Microsecond || dumps.Iterations != 6 || dumps.StdDev == 0 {
+		t.Errorf("Results[0] = %v ± %v over %d", dumps.Time, dumps.StdDev, dumps.Iterations)
+	}
+	wantMetadata := map[string]string{
+		"framework":   "pyperf",
+		"runs":        "3",
+		"warmups":     "5",
+		"loops":       "1024",
+		"inner_loops": "10",
+		"median_ns":   "11000",
+		"description": "json.dumps of a nested dict",
+	}
+	for key, want := range wantMetadata {
+		if got := dumps.Metadata[key]; got != want {
+			t.Errorf("Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	memory := suite.Results[1]
+	if memory.Time != 0 || len(memory.Metrics) != 1 || memory.Metrics[0].Name != "memory" || memory.Metrics[0].Value != 3072 {
+		t.Errorf("Results[1] = %v, metrics %+v, want memory metric 3072 B", memory.Time, memory.Metrics)
+	}
+}
+
+func TestPyperfParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "invalid JSON", input: "not json"},
+		{name: "no benchmarks", input: `{"version": "1.0", "benchmarks": []}`},
+		{name: "only warmups", input: `{"version": "1.0", "metadata": {"name": "x"}, "benchmarks": [{"runs": [{"warmups": [[1, 0.1]]}]}]}`},
+		{name: "missing name", input: `{"version": "1.0", "benchmarks": [{"runs": [{"values": [0.1]}]}]}`},
+		{name: "unknown unit", input: `{"version": "1.0", "benchmarks": [{"metadata": {"name": "x", "unit": "parsec"}, "runs": [{"values": [0.1]}]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewPyperfParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
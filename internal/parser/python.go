@@ -3,6 +3,8 @@ package parser
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 )
 
@@ -31,29 +33,31 @@ type pythonBenchmarkJSON struct {
 type pythonBenchmark struct {
 	Name      string                 `json:"name"`
 	FullName  string                 `json:"fullname"`
-	Params    interface{}            `json:"params"`
+	Params    map[string]interface{} `json:"params"` // Parametrize arguments, null when not parametrized
 	Group     *string                `json:"group"`
 	Stats     *pythonBenchmarkStats  `json:"stats"`
 	Options   map[string]interface{} `json:"options"`
-	ExtraInfo string                 `json:"extra_info"`
+	ExtraInfo json.RawMessage        `json:"extra_info"` // benchmark.extra_info object (older files: a string)
 }
 
 // pythonBenchmarkStats represents the stats for a pytest-benchmark benchmark
 type pythonBenchmarkStats struct {
-	Min         float64 `json:"min"`
-	Max         float64 `json:"max"`
-	Mean        float64 `json:"mean"`
-	StdDev      float64 `json:"stddev"`
-	Median      float64 `json:"median"`
-	Rounds      int64   `json:"rounds"`
-	IQR         float64 `json:"iqr"`
-	Q1          float64 `json:"q1"`
-	Q3          float64 `json:"q3"`
-	IQROutliers int64   `json:"iqr_outliers"`
-	Stddevs     int64   `json:"stddevs"`
-	Outliers    string  `json:"outliers"`
-	Ops         float64 `json:"ops"`
-	Total       float64 `json:"total"`
+	Min            float64   `json:"min"`
+	Max            float64   `json:"max"`
+	Mean           float64   `json:"mean"`
+	StdDev         float64   `json:"stddev"`
+	Median         float64   `json:"median"`
+	Rounds         int64     `json:"rounds"`
+	IQR            float64   `json:"iqr"`
+	Q1             float64   `json:"q1"`
+	Q3             float64   `json:"q3"`
+	IQROutliers    int64     `json:"iqr_outliers"`
+	StdDevOutliers int64     `json:"stddev_outliers"`
+	Outliers       string    `json:"outliers"`   // "<stddev outliers>;<iqr outliers>"
+	Iterations     int64     `json:"iterations"` // Iterations per round
+	Ops            float64   `json:"ops"`
+	Total          float64   `json:"total"`
+	Data           []float64 `json:"data"` // Per-round timings, present with --benchmark-save-data
 }
 
 // Parse parses pytest-benchmark JSON output
@@ -74,6 +78,8 @@ func (p *PythonParser) Parse(output []byte) (*BenchmarkSuite, error) {
 		Metadata:  make(map[string]string),
 	}
 
+	addPythonMachineInfo(suite.Metadata, data.MachineInfo)
+
 	// Parse datetime if available
 	if data.Datetime != "" {
 		suite.Metadata["datetime"] = data.Datetime
@@ -125,12 +131,28 @@ func (p *PythonParser) Parse(output []byte) (*BenchmarkSuite, error) {
 
 		// Create benchmark result
 		result := &BenchmarkResult{
-			Name:       name,
-			Language:   "python",
-			Time:       time.Duration(timeNs) * time.Nanosecond,
-			Iterations: bench.Stats.Rounds,
-			StdDev:     time.Duration(stdDevNs) * time.Nanosecond,
-			Metadata:   make(map[string]string),
+			Name:        name,
+			Language:    "python",
+			Time:        time.Duration(timeNs) * time.Nanosecond,
+			Iterations:  bench.Stats.Rounds,
+			SampleCount: bench.Stats.Rounds,
+			StdDev:      time.Duration(stdDevNs) * time.Nanosecond,
+			Metadata:    make(map[string]string),
+		}
+
+		// Keep raw round timings when pytest-benchmark saved them
+		if len(bench.Stats.Data) > 0 {
+			result.Samples = make([]time.Duration, 0, len(bench.Stats.Data))
+			for _, sec := range bench.Stats.Data {
+				if sec < 0 {
+					return nil, &ParseError{
+						Line:    i + 1,
+						Message: fmt.Sprintf("invalid sample time: %f", sec),
+						Input:   bench.FullName,
+					}
+				}
+				result.Samples = append(result.Samples, time.Duration(math.Round(sec*1e9)))
+			}
 		}
 
 		// Add throughput if available
@@ -153,6 +175,8 @@ func (p *PythonParser) Parse(output []byte) (*BenchmarkSuite, error) {
 			result.Metadata["q3"] = fmt.Sprintf("%f", bench.Stats.Q3)
 		}
 
+		bench.addMetadata(result.Metadata)
+
 		suite.Results = append(suite.Results, result)
 	}
 
@@ -164,3 +188,91 @@ func (p *PythonParser) Parse(output []byte) (*BenchmarkSuite, error) {
 
 	return suite, nil
 }
+
+// addMetadata records the full statistics (in nanoseconds), outliers, group,
+// params and extra_info of a benchmark
+func (b *pythonBenchmark) addMetadata(metadata map[string]string) {
+	stats := b.Stats
+	for key, sec := range map[string]float64{
+		MetadataMinNs:    stats.Min,
+		MetadataMaxNs:    stats.Max,
+		MetadataMedianNs: stats.Median,
+		"q1_ns":          stats.Q1,
+		"q3_ns":          stats.Q3,
+	} {
+		// Missing statistics decode as zero
+		if sec > 0 {
+			metadata[key] = formatNanos(sec * 1e9)
+		}
+	}
+	metadata["iqr_ns"] = formatNanos(stats.IQR * 1e9)
+	if stats.Iterations > 0 {
+		metadata["iterations_per_round"] = strconv.FormatInt(stats.Iterations, 10)
+	}
+	if stats.Outliers != "" {
+		metadata["outliers"] = stats.Outliers
+	}
+	metadata["iqr_outliers"] = strconv.FormatInt(stats.IQROutliers, 10)
+	metadata["stddev_outliers"] = strconv.FormatInt(stats.StdDevOutliers, 10)
+	if stats.Ops > 0 {
+		metadata["ops"] = strconv.FormatFloat(stats.Ops, 'f', -1, 64)
+	}
+
+	if b.Group != nil && *b.Group != "" {
+		metadata[MetadataGroup] = *b.Group
+	}
+	for name, value := range b.Params {
+		metadata[MetadataParamPrefix+name] = pythonValueString(value)
+	}
+
+	var extraInfo map[string]interface{}
+	if err := json.Unmarshal(b.ExtraInfo, &extraInfo); err == nil {
+		for key, value := range extraInfo {
+			metadata["extra."+key] = pythonValueString(value)
+		}
+	}
+}
+
+// addPythonMachineInfo records pytest-benchmark's machine_info as suite metadata.
+// Nested objects (e.g. the py-cpuinfo "cpu" object) are flattened as "cpu.brand_raw";
+// the CPU brand is also recorded under MetadataCPU.
+func addPythonMachineInfo(metadata map[string]string, machineInfo map[string]interface{}) {
+	for key, value := range machineInfo {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			metadata[key] = pythonValueString(value)
+			continue
+		}
+		for nestedKey, nestedValue := range nested {
+			switch nestedValue.(type) {
+			case map[string]interface{}, []interface{}:
+				// Skip deep structures such as the CPU flags list
+			default:
+				metadata[key+"."+nestedKey] = pythonValueString(nestedValue)
+			}
+		}
+	}
+
+	if cpu, ok := machineInfo["cpu"].(map[string]interface{}); ok {
+		for _, key := range []string{"brand_raw", "brand"} {
+			if brand, ok := cpu[key].(string); ok && brand != "" {
+				metadata[MetadataCPU] = brand
+				break
+			}
+		}
+	}
+}
+
+// pythonValueString formats a JSON value: scalars as text, anything else as JSON
+func pythonValueString(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(data)
+	default:
+		return jsonScalarString(value)
+	}
+}
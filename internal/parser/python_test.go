@@ -2,6 +2,7 @@ package parser
 
 import (
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -118,6 +119,9 @@ func TestPythonParser_Parse_BasicJSON(t *testing.T) {
 	if first.Iterations != 100 {
 		t.Errorf("Results[0].Iterations = %d, want %d", first.Iterations, 100)
 	}
+	if first.SampleCount != 100 {
+		t.Errorf("Results[0].SampleCount = %d, want %d", first.SampleCount, 100)
+	}
 	// StdDev is 0.0000123 seconds = 12300 nanoseconds
 	expectedStdDev := time.Duration(12300) * time.Nanosecond
 	if first.StdDev != expectedStdDev {
@@ -489,3 +493,131 @@ func TestPythonParser_Parse_MalformedJSON(t *testing.T) {
 		t.Errorf("Results[0].Name = %v, want %v", suite.Results[0].Name, "test_partial_stats")
 	}
 }
+
+func TestPythonParser_Parse_SavedData(t *testing.T) {
+	input := []byte(`{
+  "benchmarks": [
+    {
+      "name": "test_sort",
+      "fullname": "tests/test_perf.py::test_sort",
+      "stats": {
+        "min": 0.000001,
+        "max": 0.000003,
+        "mean": 0.000002,
+        "stddev": 0.000001,
+        "rounds": 3,
+        "median": 0.000002,
+        "data": [0.000001, 0.000002, 0.000003]
+      }
+    },
+    {
+      "name": "test_search",
+      "fullname": "tests/test_perf.py::test_search",
+      "stats": {
+        "mean": 0.000001,
+        "stddev": 0.0,
+        "rounds": 10
+      }
+    }
+  ]
+}`)
+
+	parser := NewPythonParser()
+	suite, err := parser.Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	first := suite.Results[0]
+	want := []time.Duration{1000 * time.Nanosecond, 2000 * time.Nanosecond, 3000 * time.Nanosecond}
+	if len(first.Samples) != len(want) {
+		t.Fatalf("len(Results[0].Samples) = %d, want %d", len(first.Samples), len(want))
+	}
+	for i, w := range want {
+		if first.Samples[i] != w {
+			t.Errorf("Results[0].Samples[%d] = %v, want %v", i, first.Samples[i], w)
+		}
+	}
+
+	if suite.Results[1].Samples != nil {
+		t.Errorf("Results[1].Samples = %v, want nil without saved data", suite.Results[1].Samples)
+	}
+}
+
+func TestPythonParser_Parse_GroupsAndParams(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/python/pytest_benchmark_groups.json")
+	if err != nil {
+		t.Fatalf("failed to read testdata: %v", err)
+	}
+
+	suite, err := NewPythonParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(suite.Results))
+	}
+
+	// machine_info becomes suite metadata, nested objects flattened
+	for key, want := range map[string]string{
+		MetadataCPU:             "AMD EPYC 7763 64-Core Processor",
+		"cpu.brand_raw":         "AMD EPYC 7763 64-Core Processor",
+		"cpu.count":             "4",
+		"node":                  "ci-runner-7",
+		"python_implementation": "CPython",
+		"python_version":        "3.12.1",
+		"python_build":          `["main","Dec 19 2023 20:23:36"]`,
+		"datetime":              "2024-01-15T10:25:03.118452+00:00",
+		"version":               "4.0.0",
+	} {
+		if got := suite.Metadata[key]; got != want {
+			t.Errorf("Suite.Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+	if _, ok := suite.Metadata["cpu.flags"]; ok {
+		t.Error("Suite.Metadata has 'cpu.flags', want nested lists skipped")
+	}
+
+	first := suite.Results[0]
+	if first.Name != "test_sort[100-random]" {
+		t.Errorf("Results[0].Name = %q, want %q", first.Name, "test_sort[100-random]")
+	}
+	for key, want := range map[string]string{
+		MetadataMinNs:          "4100",
+		MetadataMaxNs:          "26300",
+		MetadataMedianNs:       "4400",
+		"q1_ns":                "4350",
+		"q3_ns":                "4500",
+		"iqr_ns":               "150",
+		"outliers":             "312;1204",
+		"stddev_outliers":      "312",
+		"iqr_outliers":         "1204",
+		"iterations_per_round": "1",
+		MetadataGroup:          "sort",
+		"param.size":           "100",
+		"param.order":          "random",
+		"extra.algorithm":      "timsort",
+		"extra.stable":         "true",
+	} {
+		if got := first.Metadata[key]; got != want {
+			t.Errorf("Results[0].Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+	if first.Metadata["ops"] == "" {
+		t.Error("Results[0].Metadata missing 'ops'")
+	}
+
+	// Not parametrized and no group
+	last := suite.Results[2]
+	if _, ok := last.Metadata[MetadataGroup]; ok {
+		t.Errorf("Results[2].Metadata[%q] = %q, want unset", MetadataGroup, last.Metadata[MetadataGroup])
+	}
+	for key := range last.Metadata {
+		if strings.HasPrefix(key, MetadataParamPrefix) {
+			t.Errorf("Results[2].Metadata has %q, want no parameters", key)
+		}
+	}
+	if got := last.Metadata["iterations_per_round"]; got != "10" {
+		t.Errorf("Results[2].Metadata[\"iterations_per_round\"] = %q, want %q", got, "10")
+	}
+}
@@ -24,7 +24,8 @@ func (p *RustParser) Language() string {
 }
 
 // Parse parses Rust cargo bench bencher format output
-// Expected format: test bench_name ... bench:   1,234 ns/iter (+/- 56)
+// Expected format: test bench_name ... bench:   1,234 ns/iter (+/- 56) [= 830 MB/s]
+// The MB/s suffix is printed when the benchmark sets b.bytes
 func (p *RustParser) Parse(output []byte) (*BenchmarkSuite, error) {
 	suite := &BenchmarkSuite{
 		Language:  "rust",
@@ -36,8 +37,8 @@ func (p *RustParser) Parse(output []byte) (*BenchmarkSuite, error) {
 	scanner := bufio.NewScanner(bytes.NewReader(output))
 	lineNum := 0
 
-	// Regex for bencher format: test bench_name ... bench:   1,234 ns/iter (+/- 56)
-	benchRegex := regexp.MustCompile(`^test\s+(\S+)\s+\.\.\.\s+bench:\s+([\d,]+)\s+ns/iter\s+\(\+/-\s+([\d,]+)\)`)
+	// Regex for bencher format: test bench_name ... bench:   1,234 ns/iter (+/- 56) [= 830 MB/s]
+	benchRegex := regexp.MustCompile(`^test\s+(\S+)\s+\.\.\.\s+bench:\s+([\d,]+)\s+ns/iter\s+\(\+/-\s+([\d,]+)\)(?:\s+=\s+([\d,.]+)\s+MB/s)?`)
 
 	for scanner.Scan() {
 		lineNum++
@@ -93,6 +94,22 @@ func (p *RustParser) Parse(output []byte) (*BenchmarkSuite, error) {
 			Metadata:   make(map[string]string),
 		}
 
+		// Parse optional throughput (bencher reports MB/s when b.bytes is set)
+		if matches[4] != "" {
+			mbPerSec, err := strconv.ParseFloat(strings.ReplaceAll(matches[4], ",", ""), 64)
+			if err != nil {
+				return nil, &ParseError{
+					Line:    lineNum,
+					Message: fmt.Sprintf("failed to parse throughput: %v", err),
+					Input:   line,
+				}
+			}
+			result.Throughput = &Throughput{
+				Value: mbPerSec,
+				Unit:  "MB/s",
+			}
+		}
+
 		suite.Results = append(suite.Results, result)
 	}
 
@@ -69,6 +69,37 @@ test result: ok. 5 passed; 0 failed; 0 ignored; 0 measured; 0 filtered out`)
 	}
 }
 
+func TestRustParser_Parse_Throughput(t *testing.T) {
+	input := []byte(`test bench_copy   ... bench:       1,234 ns/iter (+/- 56) = 830 MB/s
+test bench_hash   ... bench:      12,345 ns/iter (+/- 678) = 1,024.5 MB/s
+test bench_plain  ... bench:         567 ns/iter (+/- 23)`)
+
+	suite, err := NewRustParser().Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want %d", len(suite.Results), 3)
+	}
+
+	copyBench := suite.Results[0]
+	if copyBench.Time != 1234*time.Nanosecond {
+		t.Errorf("Results[0].Time = %v, want %v", copyBench.Time, 1234*time.Nanosecond)
+	}
+	if copyBench.Throughput == nil || copyBench.Throughput.Value != 830 || copyBench.Throughput.Unit != "MB/s" {
+		t.Errorf("Results[0].Throughput = %+v, want 830 MB/s", copyBench.Throughput)
+	}
+
+	if tp := suite.Results[1].Throughput; tp == nil || tp.Value != 1024.5 {
+		t.Errorf("Results[1].Throughput = %+v, want 1024.5 MB/s", tp)
+	}
+
+	if suite.Results[2].Throughput != nil {
+		t.Errorf("Results[2].Throughput = %+v, want nil", suite.Results[2].Throughput)
+	}
+}
+
 func TestRustParser_Parse_WithWarnings(t *testing.T) {
 	data, err := os.ReadFile("../../testdata/rust/cargo_bench_with_warnings.txt")
 	if err != nil {
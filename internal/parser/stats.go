@@ -0,0 +1,63 @@
+package parser
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// summarizeSamples calculates the mean, median, and sample standard deviation of raw samples
+func summarizeSamples(samples []time.Duration) (mean, median, stdDev time.Duration) {
+	if len(samples) == 0 {
+		return 0, 0, 0
+	}
+
+	// Calculate mean
+	sum := 0.0
+	for _, s := range samples {
+		sum += float64(s)
+	}
+	meanFloat := sum / float64(len(samples))
+
+	// Calculate median
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		median = (sorted[mid-1] + sorted[mid]) / 2
+	} else {
+		median = sorted[mid]
+	}
+
+	// Calculate sample standard deviation (n-1)
+	if len(samples) > 1 {
+		varianceSum := 0.0
+		for _, s := range samples {
+			diff := float64(s) - meanFloat
+			varianceSum += diff * diff
+		}
+		stdDev = time.Duration(math.Sqrt(varianceSum / float64(len(samples)-1)))
+	}
+
+	return time.Duration(meanFloat), median, stdDev
+}
+
+// relativeMarginOfError returns the margin of error of the mean at 95% confidence,
+// as a percentage of the mean, using the normal approximation (z = 1.96)
+func relativeMarginOfError(samples []time.Duration) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+
+	mean, _, stdDev := summarizeSamples(samples)
+	if mean <= 0 {
+		return 0
+	}
+
+	standardError := float64(stdDev) / math.Sqrt(float64(len(samples)))
+	return 1.96 * standardError / float64(mean) * 100
+}
@@ -4,13 +4,93 @@ import "time"
 
 // BenchmarkResult represents a single benchmark result
 type BenchmarkResult struct {
-	Name       string            // Benchmark name (e.g., "bench_sort")
-	Language   string            // Language (e.g., "rust", "python", "go")
-	Time       time.Duration     // Average time per iteration
-	Iterations int64             // Number of iterations
-	StdDev     time.Duration     // Standard deviation
-	Throughput *Throughput       // Optional throughput metrics
-	Metadata   map[string]string // Additional metadata
+	Name        string            // Benchmark name (e.g., "bench_sort")
+	Language    string            // Language (e.g., "rust", "python", "go")
+	Time        time.Duration     // Average time per iteration
+	Iterations  int64             // Number of iterations
+	StdDev      time.Duration     // Standard deviation
+	SampleCount int64             // Independent measurements (rounds, repetitions) behind Time and StdDev; 0 when unknown
+	Throughput  *Throughput       // Optional throughput metrics
+	Memory      *MemoryStats      // Optional memory metrics (nil when not measured)
+	Metrics     []Metric          // Optional additional named metrics (e.g., instructions, RSS)
+	Samples     []time.Duration   // Optional raw per-run samples (time per iteration)
+	Metadata    map[string]string // Additional metadata
+}
+
+// Standard metric names used by AllMetrics
+const (
+	MetricTimePerOp   = "time_per_op"
+	MetricBytesPerOp  = "bytes_per_op"
+	MetricAllocsPerOp = "allocs_per_op"
+	MetricThroughput  = "throughput"
+
+	// MetricInstructions is an instruction count (e.g., iai-callgrind), a deterministic cost
+	MetricInstructions = "instructions"
+)
+
+// Environment metadata keys, set on suites and results by parsers whose output reports them
+// (e.g., the goos/goarch/pkg/cpu headers of `go test -bench`)
+const (
+	MetadataGOOS    = "goos"
+	MetadataGOARCH  = "goarch"
+	MetadataCPU     = "cpu"
+	MetadataPackage = "package"
+)
+
+// Summary statistic metadata keys, in nanoseconds, set by parsers whose output reports
+// them. Without raw samples, the aggregator takes Median, Min and Max from them.
+const (
+	MetadataMedianNs = "median_ns"
+	MetadataMinNs    = "min_ns"
+	MetadataMaxNs    = "max_ns"
+)
+
+// Label metadata keys: the group a benchmark belongs to and its parameters,
+// one "param.<name>" key per parameter (e.g., "param.size" = "1000")
+const (
+	MetadataGroup       = "group"
+	MetadataParamPrefix = "param."
+)
+
+// Metric represents a named measurement with a unit and a better direction
+type Metric struct {
+	Name           string    // Metric name (e.g., "instructions", "cache-misses")
+	Unit           string    // Display unit (e.g., "instr/op", "MB")
+	Value          float64   // Measured value (mean of Samples when they are present)
+	HigherIsBetter bool      // true for throughput-like metrics, false for costs
+	Deterministic  bool      // true for counts without measurement noise (allocations, simulated instructions)
+	Samples        []float64 // Optional raw per-run values
+}
+
+// AllMetrics returns every measurement of the result as named metrics:
+// time per operation, memory, throughput and any additional metrics
+func (r *BenchmarkResult) AllMetrics() []Metric {
+	metrics := make([]Metric, 0, 4+len(r.Metrics))
+
+	timeMetric := Metric{Name: MetricTimePerOp, Unit: "ns/op", Value: float64(r.Time.Nanoseconds())}
+	for _, s := range r.Samples {
+		timeMetric.Samples = append(timeMetric.Samples, float64(s.Nanoseconds()))
+	}
+	metrics = append(metrics, timeMetric)
+
+	if r.Memory != nil {
+		metrics = append(metrics,
+			Metric{Name: MetricBytesPerOp, Unit: "B/op", Value: float64(r.Memory.BytesPerOp), Deterministic: true},
+			Metric{Name: MetricAllocsPerOp, Unit: "allocs/op", Value: float64(r.Memory.AllocsPerOp), Deterministic: true},
+		)
+	}
+
+	if r.Throughput != nil {
+		metrics = append(metrics, Metric{Name: MetricThroughput, Unit: r.Throughput.Unit, Value: r.Throughput.Value, HigherIsBetter: true})
+	}
+
+	return append(metrics, r.Metrics...)
+}
+
+// MemoryStats represents memory allocation metrics per operation
+type MemoryStats struct {
+	BytesPerOp  int64 // Bytes allocated per operation
+	AllocsPerOp int64 // Heap allocations per operation
 }
 
 // Throughput represents throughput metrics (bytes/sec, ops/sec, etc.)
@@ -3,6 +3,7 @@ package parser
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestParseError_Error(t *testing.T) {
@@ -40,3 +41,44 @@ func TestParseError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchmarkResult_AllMetrics(t *testing.T) {
+	result := &BenchmarkResult{
+		Time:       1200 * time.Nanosecond,
+		Samples:    []time.Duration{1100 * time.Nanosecond, 1300 * time.Nanosecond},
+		Memory:     &MemoryStats{BytesPerOp: 64, AllocsPerOp: 2},
+		Throughput: &Throughput{Value: 830, Unit: "MB/s"},
+		Metrics:    []Metric{{Name: "instructions", Unit: "instr", Value: 1520}},
+	}
+
+	metrics := result.AllMetrics()
+
+	want := []struct {
+		name           string
+		unit           string
+		value          float64
+		higherIsBetter bool
+	}{
+		{MetricTimePerOp, "ns/op", 1200, false},
+		{MetricBytesPerOp, "B/op", 64, false},
+		{MetricAllocsPerOp, "allocs/op", 2, false},
+		{MetricThroughput, "MB/s", 830, true},
+		{"instructions", "instr", 1520, false},
+	}
+	if len(metrics) != len(want) {
+		t.Fatalf("len(AllMetrics()) = %d, want %d", len(metrics), len(want))
+	}
+	for i, w := range want {
+		m := metrics[i]
+		if m.Name != w.name || m.Unit != w.unit || m.Value != w.value || m.HigherIsBetter != w.higherIsBetter {
+			t.Errorf("AllMetrics()[%d] = %+v, want %+v", i, m, w)
+		}
+	}
+	if len(metrics[0].Samples) != 2 || metrics[0].Samples[1] != 1300 {
+		t.Errorf("expected time samples in nanoseconds, got %v", metrics[0].Samples)
+	}
+
+	if got := (&BenchmarkResult{Time: 5}).AllMetrics(); len(got) != 1 {
+		t.Errorf("expected only time for a bare result, got %+v", got)
+	}
+}
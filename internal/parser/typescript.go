@@ -123,11 +123,12 @@ func (p *TypeScriptParser) Parse(output []byte) (*BenchmarkSuite, error) {
 
 		// Create benchmark result
 		result := &BenchmarkResult{
-			Name:       name,
-			Language:   "typescript",
-			Time:       time.Duration(int64(timePerOpNs)) * time.Nanosecond,
-			Iterations: runs,
-			StdDev:     time.Duration(int64(stdDevNs)) * time.Nanosecond,
+			Name:        name,
+			Language:    "typescript",
+			Time:        time.Duration(int64(timePerOpNs)) * time.Nanosecond,
+			Iterations:  runs,
+			SampleCount: runs,
+			StdDev:      time.Duration(int64(stdDevNs)) * time.Nanosecond,
 			Throughput: &Throughput{
 				Value: opsPerSec,
 				Unit:  "ops/s",
@@ -0,0 +1,154 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// VitestParser implements Parser for `vitest bench --outputJson` reports, whose
+// statistics come from tinybench
+type VitestParser struct{}
+
+// NewVitestParser creates a new vitest bench parser
+func NewVitestParser() *VitestParser {
+	return &VitestParser{}
+}
+
+// Language returns the language this parser supports
+func (p *VitestParser) Language() string {
+	return "nodejs"
+}
+
+// vitestReportJSON represents a vitest bench JSON report
+type vitestReportJSON struct {
+	Files []vitestFile `json:"files"`
+}
+
+// vitestFile is one benchmark file with its describe() groups
+type vitestFile struct {
+	Filepath string        `json:"filepath"`
+	Groups   []vitestGroup `json:"groups"`
+}
+
+// vitestGroup is a describe() block; FullName includes the file, e.g. "bench/sort.bench.ts > sorting"
+type vitestGroup struct {
+	FullName   string            `json:"fullName"`
+	Benchmarks []vitestBenchmark `json:"benchmarks"`
+}
+
+// vitestBenchmark is a tinybench task result; times are in milliseconds
+type vitestBenchmark struct {
+	Name        string    `json:"name"`
+	Rank        int       `json:"rank"`
+	Hz          float64   `json:"hz"` // Operations per second
+	Mean        float64   `json:"mean"`
+	Median      float64   `json:"median"`
+	Min         float64   `json:"min"`
+	Max         float64   `json:"max"`
+	SD          float64   `json:"sd"`
+	RME         float64   `json:"rme"` // Relative margin of error, in percent
+	P75         float64   `json:"p75"`
+	P99         float64   `json:"p99"`
+	P995        float64   `json:"p995"`
+	P999        float64   `json:"p999"`
+	SampleCount int64     `json:"sampleCount"`
+	Samples     []float64 `json:"samples"` // Only kept with --includeSamples
+	TotalTime   float64   `json:"totalTime"`
+}
+
+// Parse parses a vitest bench JSON report.
+// Results are named after their file and describe() blocks, e.g.
+// "bench/sort.bench.ts > sorting > Array.prototype.sort".
+func (p *VitestParser) Parse(output []byte) (*BenchmarkSuite, error) {
+	var data vitestReportJSON
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, &ParseError{
+			Message: fmt.Sprintf("failed to parse JSON: %v", err),
+			Input:   string(output),
+		}
+	}
+
+	suite := &BenchmarkSuite{
+		Language:  "nodejs",
+		Timestamp: time.Now(),
+		Results:   make([]*BenchmarkResult, 0),
+		Metadata:  make(map[string]string),
+	}
+
+	for _, file := range data.Files {
+		for _, group := range file.Groups {
+			prefix := group.FullName
+			if prefix == "" {
+				prefix = file.Filepath
+			}
+			for _, bench := range group.Benchmarks {
+				if bench.Mean < 0 {
+					return nil, &ParseError{
+						Message: fmt.Sprintf("invalid mean time: %f", bench.Mean),
+						Input:   prefix + " > " + bench.Name,
+					}
+				}
+				suite.Results = append(suite.Results, bench.result(prefix))
+			}
+		}
+	}
+
+	if len(suite.Results) == 0 {
+		return nil, &ParseError{
+			Message: "no benchmark results found in output",
+		}
+	}
+
+	return suite, nil
+}
+
+// result converts a tinybench task result into a BenchmarkResult
+func (b *vitestBenchmark) result(group string) *BenchmarkResult {
+	result := &BenchmarkResult{
+		Name:        group + " > " + b.Name,
+		Language:    "nodejs",
+		Time:        millisecondsToDuration(b.Mean),
+		StdDev:      millisecondsToDuration(b.SD),
+		Iterations:  b.SampleCount,
+		SampleCount: b.SampleCount,
+		Metadata: map[string]string{
+			"framework":   "vitest",
+			MetadataGroup: group,
+			"rme":         strconv.FormatFloat(b.RME, 'f', -1, 64),
+			"rank":        strconv.Itoa(b.Rank),
+		},
+	}
+	if b.Hz > 0 {
+		result.Throughput = &Throughput{Value: b.Hz, Unit: "ops/s"}
+	}
+
+	for key, ms := range map[string]float64{
+		MetadataMedianNs: b.Median,
+		MetadataMinNs:    b.Min,
+		MetadataMaxNs:    b.Max,
+		"p75_ns":         b.P75,
+		"p99_ns":         b.P99,
+		"p995_ns":        b.P995,
+		"p999_ns":        b.P999,
+	} {
+		if ms > 0 {
+			result.Metadata[key] = formatNanos(ms * 1e6)
+		}
+	}
+
+	for _, ms := range b.Samples {
+		result.Samples = append(result.Samples, millisecondsToDuration(ms))
+	}
+	if result.Iterations == 0 {
+		result.Iterations = int64(len(result.Samples))
+	}
+
+	return result
+}
+
+// millisecondsToDuration converts milliseconds to a duration rounded to the nanosecond
+func millisecondsToDuration(ms float64) time.Duration {
+	return secondsToDuration(ms / 1e3)
+}
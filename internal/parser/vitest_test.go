@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestVitestParser_Language(t *testing.T) {
+	if got := NewVitestParser().Language(); got != "nodejs" {
+		t.Errorf("Language() = %v, want nodejs", got)
+	}
+}
+
+func TestVitestParser_Parse(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/nodejs/vitest_bench.json")
+	if err != nil {
+		t.Fatalf("failed to read testdata: %v", err)
+	}
+
+	suite, err := NewVitestParser().Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+	if len(suite.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(suite.Results))
+	}
+
+	wantNames := []string{
+		"bench/sort.bench.ts > sorting > Array.prototype.sort",
+		"bench/sort.bench.ts > sorting > lodash.sortBy",
+		"bench/parse.bench.ts > JSON.parse",
+	}
+	for i, want := range wantNames {
+		if suite.Results[i].Name != want {
+			t.Errorf("Results[%d].Name = %q, want %q", i, suite.Results[i].Name, want)
+		}
+	}
+
+	sort := suite.Results[0]
+	if sort.Time != 81*time.Microsecond || sort.StdDev != 17300*time.Nanosecond || sort.Iterations != 6173 {
+		t.Errorf("Results[0] = %v ± %v over %d", sort.Time, sort.StdDev, sort.Iterations)
+	}
+	if sort.Throughput == nil || sort.Throughput.Value != 12345.6789 || sort.Throughput.Unit != "ops/s" {
+		t.Errorf("Results[0].Throughput = %+v, want 12345.6789 ops/s", sort.Throughput)
+	}
+	for key, want := range map[string]string{
+		"framework":      "vitest",
+		MetadataGroup:    "bench/sort.bench.ts > sorting",
+		"rme":            "0.5215",
+		"rank":           "1",
+		MetadataMedianNs: "79500",
+		MetadataMinNs:    "71200",
+		MetadataMaxNs:    "410200",
+		"p75_ns":         "82100",
+		"p99_ns":         "150300",
+		"p999_ns":        "301100",
+	} {
+		if got := sort.Metadata[key]; got != want {
+			t.Errorf("Results[0].Metadata[%q] = %q, want %q", key, got, want)
+		}
+	}
+	if sort.Samples != nil {
+		t.Errorf("Results[0].Samples = %v, want nil without --includeSamples", sort.Samples)
+	}
+
+	lodash := suite.Results[1]
+	wantSamples := []time.Duration{140100 * time.Nanosecond, 150200 * time.Nanosecond, 150800 * time.Nanosecond, 210200 * time.Nanosecond}
+	if len(lodash.Samples) != len(wantSamples) {
+		t.Fatalf("len(Results[1].Samples) = %d, want %d", len(lodash.Samples), len(wantSamples))
+	}
+	for i, want := range wantSamples {
+		if lodash.Samples[i] != want {
+			t.Errorf("Results[1].Samples[%d] = %v, want %v", i, lodash.Samples[i], want)
+		}
+	}
+}
+
+func TestVitestParser_Parse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"invalid JSON", `{"files": [`},
+		{"no benchmarks", `{"files": [{"filepath": "a.bench.ts", "groups": [{"fullName": "a.bench.ts", "benchmarks": []}]}]}`},
+		{"negative mean", `{"files": [{"filepath": "a.bench.ts", "groups": [{"fullName": "a.bench.ts", "benchmarks": [{"name": "x", "mean": -1}]}]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewVitestParser().Parse([]byte(tt.input)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
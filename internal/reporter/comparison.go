@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"html"
+	"math"
 	"sort"
 
 	"github.com/jpequegn/benchflow/internal/comparator"
+	"github.com/jpequegn/benchflow/internal/parser"
 )
 
 // ComparisonReporter generates comparison reports in various formats
@@ -26,7 +29,7 @@ func NewBasicComparisonReporter() *BasicComparisonReporter {
 
 // GenerateMarkdown generates a Markdown comparison report
 func (bcr *BasicComparisonReporter) GenerateMarkdown(result *comparator.ComparisonResult) (string, error) {
-	if result == nil || len(result.Benchmarks) == 0 {
+	if result == nil || (len(result.Benchmarks) == 0 && !hasSuiteChanges(result)) {
 		return "# Comparison Report\n\nNo benchmarks to compare.\n", nil
 	}
 
@@ -43,7 +46,23 @@ func (bcr *BasicComparisonReporter) GenerateMarkdown(result *comparator.Comparis
 	buf.WriteString(fmt.Sprintf("- **Average Delta**: %.2f%%\n", result.Summary.AverageDelta))
 	buf.WriteString(fmt.Sprintf("- **Max Delta**: %.2f%%\n", result.Summary.MaxDelta))
 	buf.WriteString(fmt.Sprintf("- **Min Delta**: %.2f%%\n", result.Summary.MinDelta))
-	buf.WriteString(fmt.Sprintf("- **Significant Changes**: %d\n\n", result.Summary.SignificantChanges))
+	buf.WriteString(fmt.Sprintf("- **Significant Changes**: %d\n", result.Summary.SignificantChanges))
+	if result.Summary.ThresholdFallbacks > 0 {
+		buf.WriteString(fmt.Sprintf("- **Threshold Only (no variance data)**: %d\n", result.Summary.ThresholdFallbacks))
+	}
+	buf.WriteString(fmt.Sprintf("- **Statistical Test**: %s\n", testLabel(result.Statistics.Test)))
+	buf.WriteString(fmt.Sprintf("- **Multiple-Comparison Correction**: %s\n", correctionLabel(result.Statistics.Correction)))
+	buf.WriteString(fmt.Sprintf("- **Regression Policy**: %s\n\n", policyLabel(result.Statistics)))
+
+	// Environment mismatches make every comparison below suspect, so they come first
+	if len(result.EnvironmentMismatches) > 0 {
+		buf.WriteString("## ⚠️ Environment Mismatches\n\n")
+		buf.WriteString("The runs were taken in different environments; results may not be comparable.\n\n")
+		for _, mismatch := range result.EnvironmentMismatches {
+			buf.WriteString(fmt.Sprintf("- **%s**: `%s` → `%s`\n", mismatch.Key, mismatch.Baseline, mismatch.Current))
+		}
+		buf.WriteString("\n")
+	}
 
 	// Regressions section
 	if len(result.Regressions) > 0 {
@@ -63,9 +82,72 @@ func (bcr *BasicComparisonReporter) GenerateMarkdown(result *comparator.Comparis
 		buf.WriteString("\n")
 	}
 
+	// Added, removed, renamed and mismatched benchmarks
+	if len(result.Added) > 0 {
+		buf.WriteString("## ➕ Added Benchmarks\n\n")
+		for _, name := range result.Added {
+			buf.WriteString(fmt.Sprintf("- `%s`\n", name))
+		}
+		buf.WriteString("\n")
+	}
+
+	if len(result.Removed) > 0 {
+		buf.WriteString("## ➖ Removed Benchmarks\n\n")
+		for _, name := range result.Removed {
+			buf.WriteString(fmt.Sprintf("- `%s`\n", name))
+		}
+		buf.WriteString("\n")
+	}
+
+	if len(result.Renamed) > 0 {
+		buf.WriteString("## 🔀 Renamed Benchmarks\n\n")
+		for _, rename := range result.Renamed {
+			buf.WriteString(fmt.Sprintf("- `%s` → `%s`\n", rename.Old, rename.New))
+		}
+		buf.WriteString("\n")
+	}
+
+	if len(result.LanguageMismatches) > 0 {
+		buf.WriteString("## ⚠️ Language Mismatches\n\n")
+		for _, mismatch := range result.LanguageMismatches {
+			buf.WriteString(fmt.Sprintf("- `%s`: %s → %s (not compared)\n", mismatch.Name, mismatch.BaselineLanguage, mismatch.CurrentLanguage))
+		}
+		buf.WriteString("\n")
+	}
+
 	// Detailed results table
-	buf.WriteString("## Detailed Results\n\n")
-	buf.WriteString(bcr.generateMarkdownTable(result.Benchmarks))
+	if len(result.Benchmarks) > 0 {
+		buf.WriteString("## Detailed Results\n\n")
+		buf.WriteString(bcr.generateMarkdownTable(result.Benchmarks))
+	}
+
+	// Memory and other non-time metrics
+	if rows := metricRows(result.Benchmarks); len(rows) > 0 {
+		buf.WriteString("\n## Metrics\n\n")
+		buf.WriteString("| Benchmark | Metric | Baseline | Current | Delta | Threshold | Status | P-Value |\n")
+		buf.WriteString("|-----------|--------|----------|---------|-------|-----------|--------|---------|\n")
+		for _, row := range rows {
+			status := "→"
+			if row.metric.IsRegression {
+				status = "🔴"
+			} else if row.metric.ExceedsThreshold {
+				// Beyond the threshold, but noisy and not shown to be significant
+				status = "⚠️"
+			} else if row.metric.Improved() {
+				status = "🟢"
+			}
+			buf.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s | %s | %s |\n",
+				row.benchmark,
+				row.metric.Unit,
+				comparator.FormatMetricValue(row.metric.Baseline),
+				comparator.FormatMetricValue(row.metric.Current),
+				formatMetricDelta(row.metric),
+				metricThresholdLabel(row.metric),
+				status,
+				metricPValueLabel(row.metric),
+			))
+		}
+	}
 
 	return buf.String(), nil
 }
@@ -79,8 +161,8 @@ func (bcr *BasicComparisonReporter) generateMarkdownTable(comparisons []*compara
 	var buf bytes.Buffer
 
 	// Table header
-	buf.WriteString("| Benchmark | Language | Baseline | Current | Delta | Status | P-Value | Effect Size |\n")
-	buf.WriteString("|-----------|----------|----------|---------|-------|--------|---------|-------------|\n")
+	buf.WriteString("| Benchmark | Language | Baseline | Current | Delta | Status | Test | P-Value | Adj. P-Value | Effect Size | Reason |\n")
+	buf.WriteString("|-----------|----------|----------|---------|-------|--------|------|---------|--------------|-------------|--------|\n")
 
 	// Sort comparisons by name
 	sorted := make([]*comparator.BenchmarkComparison, len(comparisons))
@@ -93,22 +175,22 @@ func (bcr *BasicComparisonReporter) generateMarkdownTable(comparisons []*compara
 		status := "→"
 		if comp.IsRegression {
 			status = "🔴"
-		} else if comp.TimeDelta < 0 {
+		} else if comp.Faster() {
 			status = "🟢"
 		}
 
-		baselineNs := comp.Baseline.Time.Nanoseconds()
-		currentNs := comp.Current.Time.Nanoseconds()
-
-		buf.WriteString(fmt.Sprintf("| %s | %s | %d ns | %d ns | %.2f%% | %s | %.4f | %.2f |\n",
+		buf.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s | %s | %.4f | %.4f | %.2f | %s |\n",
 			comp.Name,
 			comp.Language,
-			baselineNs,
-			currentNs,
-			comp.TimeDelta,
+			formatMeasurement(comp, comp.Baseline),
+			formatMeasurement(comp, comp.Current),
+			formatDelta(comp),
 			status,
-			comp.TTestPValue,
+			testLabel(comp.Test),
+			comp.PValue,
+			comp.AdjustedPValue,
 			comp.EffectSize,
+			comp.RegressionReason,
 		))
 	}
 
@@ -117,7 +199,7 @@ func (bcr *BasicComparisonReporter) generateMarkdownTable(comparisons []*compara
 
 // GenerateHTML generates an HTML comparison report (placeholder)
 func (bcr *BasicComparisonReporter) GenerateHTML(result *comparator.ComparisonResult) (string, error) {
-	if result == nil || len(result.Benchmarks) == 0 {
+	if result == nil || (len(result.Benchmarks) == 0 && !hasSuiteChanges(result)) {
 		return "<h1>Comparison Report</h1><p>No benchmarks to compare.</p>", nil
 	}
 
@@ -159,9 +241,37 @@ func (bcr *BasicComparisonReporter) GenerateHTML(result *comparator.ComparisonRe
 	buf.WriteString(fmt.Sprintf(`			<div class="stat-box"><div class="stat-label">Regressions</div><div class="stat-value" style="color: #dc3545;">%d</div></div>`, result.Summary.Regressions))
 	buf.WriteString(fmt.Sprintf(`			<div class="stat-box"><div class="stat-label">Improvements</div><div class="stat-value" style="color: #28a745;">%d</div></div>`, result.Summary.Improvements))
 	buf.WriteString(fmt.Sprintf(`			<div class="stat-box"><div class="stat-label">Average Delta</div><div class="stat-value">%.2f%%</div></div>`, result.Summary.AverageDelta))
+	if result.Summary.ThresholdFallbacks > 0 {
+		buf.WriteString(fmt.Sprintf(`			<div class="stat-box"><div class="stat-label">Threshold Only (no variance data)</div><div class="stat-value">%d</div></div>`, result.Summary.ThresholdFallbacks))
+	}
+	buf.WriteString(fmt.Sprintf(`			<div class="stat-box"><div class="stat-label">Statistical Test</div><div class="stat-value">%s</div></div>`, testLabel(result.Statistics.Test)))
+	buf.WriteString(fmt.Sprintf(`			<div class="stat-box"><div class="stat-label">Correction</div><div class="stat-value">%s</div></div>`, correctionLabel(result.Statistics.Correction)))
+	buf.WriteString(fmt.Sprintf(`			<div class="stat-box"><div class="stat-label">Regression Policy</div><div class="stat-value" style="font-size: 14px;">%s</div></div>`, html.EscapeString(policyLabel(result.Statistics))))
 	buf.WriteString(`		</div>
 `)
 
+	environment := make([]string, 0, len(result.EnvironmentMismatches))
+	for _, mismatch := range result.EnvironmentMismatches {
+		environment = append(environment, mismatch.String())
+	}
+	writeHTMLList(&buf, "Environment Mismatches", environment)
+
+	// Added, removed, renamed and mismatched benchmarks
+	writeHTMLList(&buf, "Added Benchmarks", result.Added)
+	writeHTMLList(&buf, "Removed Benchmarks", result.Removed)
+
+	renamed := make([]string, 0, len(result.Renamed))
+	for _, rename := range result.Renamed {
+		renamed = append(renamed, fmt.Sprintf("%s → %s", rename.Old, rename.New))
+	}
+	writeHTMLList(&buf, "Renamed Benchmarks", renamed)
+
+	mismatches := make([]string, 0, len(result.LanguageMismatches))
+	for _, mismatch := range result.LanguageMismatches {
+		mismatches = append(mismatches, fmt.Sprintf("%s: %s → %s (not compared)", mismatch.Name, mismatch.BaselineLanguage, mismatch.CurrentLanguage))
+	}
+	writeHTMLList(&buf, "Language Mismatches", mismatches)
+
 	// Detailed results table
 	buf.WriteString(`		<h2>Detailed Results</h2>
 		<table>
@@ -172,8 +282,11 @@ func (bcr *BasicComparisonReporter) GenerateHTML(result *comparator.ComparisonRe
 					<th>Baseline</th>
 					<th>Current</th>
 					<th>Delta</th>
+					<th>Test</th>
 					<th>P-Value</th>
+					<th>Adj. P-Value</th>
 					<th>Effect Size</th>
+					<th>Reason</th>
 				</tr>
 			</thead>
 			<tbody>
@@ -190,28 +303,71 @@ func (bcr *BasicComparisonReporter) GenerateHTML(result *comparator.ComparisonRe
 		statusClass := ""
 		if comp.IsRegression {
 			statusClass = `class="regression"`
-		} else if comp.TimeDelta < 0 {
+		} else if comp.Faster() {
 			statusClass = `class="improvement"`
 		}
 
-		baselineNs := comp.Baseline.Time.Nanoseconds()
-		currentNs := comp.Current.Time.Nanoseconds()
-
 		buf.WriteString(fmt.Sprintf(`				<tr>
 					<td>%s</td>
 					<td>%s</td>
-					<td>%d ns</td>
-					<td>%d ns</td>
-					<td %s>%.2f%%</td>
+					<td>%s</td>
+					<td>%s</td>
+					<td %s>%s</td>
+					<td>%s</td>
+					<td>%.4f</td>
 					<td>%.4f</td>
 					<td>%.2f</td>
+					<td>%s</td>
 				</tr>
-`, comp.Name, comp.Language, baselineNs, currentNs, statusClass, comp.TimeDelta, comp.TTestPValue, comp.EffectSize))
+`, comp.Name, comp.Language, html.EscapeString(formatMeasurement(comp, comp.Baseline)), html.EscapeString(formatMeasurement(comp, comp.Current)), statusClass, formatDelta(comp), testLabel(comp.Test), comp.PValue, comp.AdjustedPValue, comp.EffectSize, html.EscapeString(comp.RegressionReason)))
 	}
 
 	buf.WriteString(`			</tbody>
 		</table>
-	</div>
+`)
+
+	// Memory and other non-time metrics
+	if rows := metricRows(result.Benchmarks); len(rows) > 0 {
+		buf.WriteString(`		<h2>Metrics</h2>
+		<table>
+			<thead>
+				<tr>
+					<th>Benchmark</th>
+					<th>Metric</th>
+					<th>Baseline</th>
+					<th>Current</th>
+					<th>Delta</th>
+					<th>Threshold</th>
+					<th>P-Value</th>
+				</tr>
+			</thead>
+			<tbody>
+`)
+		for _, row := range rows {
+			statusClass := ""
+			if row.metric.IsRegression {
+				statusClass = `class="regression"`
+			} else if row.metric.Improved() {
+				statusClass = `class="improvement"`
+			}
+
+			buf.WriteString(fmt.Sprintf(`				<tr>
+					<td>%s</td>
+					<td>%s</td>
+					<td>%s</td>
+					<td>%s</td>
+					<td %s>%s</td>
+					<td>%s</td>
+					<td>%s</td>
+				</tr>
+`, html.EscapeString(row.benchmark), html.EscapeString(row.metric.Unit), comparator.FormatMetricValue(row.metric.Baseline), comparator.FormatMetricValue(row.metric.Current), statusClass, formatMetricDelta(row.metric), metricThresholdLabel(row.metric), metricPValueLabel(row.metric)))
+		}
+		buf.WriteString(`			</tbody>
+		</table>
+`)
+	}
+
+	buf.WriteString(`	</div>
 </body>
 </html>
 `)
@@ -235,14 +391,27 @@ func (bcr *BasicComparisonReporter) GenerateJSON(result *comparator.ComparisonRe
 			"max_delta":           result.Summary.MaxDelta,
 			"min_delta":           result.Summary.MinDelta,
 			"significant_changes": result.Summary.SignificantChanges,
+			"threshold_fallbacks": result.Summary.ThresholdFallbacks,
 		},
-		"regressions":  result.Regressions,
-		"improvements": result.Improvements,
-		"benchmarks":   bcr.marshalBenchmarkComparisons(result.Benchmarks),
+		"regressions":            result.Regressions,
+		"improvements":           result.Improvements,
+		"added":                  nonNilStrings(result.Added),
+		"removed":                nonNilStrings(result.Removed),
+		"renamed":                marshalRenames(result.Renamed),
+		"language_mismatches":    marshalLanguageMismatches(result.LanguageMismatches),
+		"environment_mismatches": marshalEnvironmentMismatches(result.EnvironmentMismatches),
+		"benchmarks":             bcr.marshalBenchmarkComparisons(result.Benchmarks),
 		"statistics": map[string]interface{}{
 			"confidence_level":     result.Statistics.ConfidenceLevel,
 			"significance_level":   result.Statistics.SignificanceLevel,
 			"regression_threshold": result.Statistics.RegressionThreshold,
+			"statistical_test":     string(result.Statistics.Test),
+			"correction":           string(result.Statistics.Correction),
+			"policy":               string(result.Statistics.Policy),
+			"min_effect_size":      result.Statistics.MinEffectSize,
+			"bootstrap_resamples":  result.Statistics.BootstrapResamples,
+			"bootstrap_seed":       result.Statistics.BootstrapSeed,
+			"metric_thresholds":    result.Statistics.MetricThresholds,
 		},
 	}
 
@@ -260,20 +429,275 @@ func (bcr *BasicComparisonReporter) marshalBenchmarkComparisons(comparisons []*c
 	results := make([]map[string]interface{}, 0, len(comparisons))
 
 	for _, comp := range comparisons {
-		results = append(results, map[string]interface{}{
+		entry := map[string]interface{}{
 			"name":                 comp.Name,
 			"language":             comp.Language,
 			"baseline_time_ns":     comp.Baseline.Time.Nanoseconds(),
 			"current_time_ns":      comp.Current.Time.Nanoseconds(),
 			"time_delta_percent":   comp.TimeDelta,
 			"is_regression":        comp.IsRegression,
+			"regression_reason":    comp.RegressionReason,
+			"exceeds_threshold":    comp.ExceedsThreshold,
 			"is_significant":       comp.IsSignificant,
 			"confidence_level":     comp.ConfidenceLevel,
-			"t_test_p_value":       comp.TTestPValue,
+			"statistical_test":     string(comp.Test),
+			"threshold_fallback":   comp.ThresholdFallback,
+			"test_statistic":       jsonFloat(comp.TestStatistic),
+			"degrees_of_freedom":   comp.DegreesOfFreedom,
+			"p_value":              comp.PValue,
+			"t_test_p_value":       comp.PValue, // Deprecated alias of p_value, kept for existing consumers
+			"adjusted_p_value":     comp.AdjustedPValue,
 			"effect_size_cohens_d": comp.EffectSize,
 			"regression_threshold": comp.RegressionThreshold,
+		}
+		if lower, upper, ok := comp.DeltaCI(); ok {
+			entry["delta_ci_lower_percent"] = lower
+			entry["delta_ci_upper_percent"] = upper
+		}
+		if comp.Throughput != nil {
+			entry["throughput"] = marshalMetric(comp.Throughput)
+		}
+		if len(comp.Metrics) > 0 {
+			entry["metrics"] = marshalMetrics(comp.Metrics)
+		}
+		results = append(results, entry)
+	}
+
+	return results
+}
+
+// metricRow pairs a metric comparison with its benchmark name
+type metricRow struct {
+	benchmark string
+	metric    *comparator.MetricComparison
+}
+
+// metricRows flattens the metric comparisons of all benchmarks, sorted by benchmark name
+func metricRows(comparisons []*comparator.BenchmarkComparison) []metricRow {
+	sorted := make([]*comparator.BenchmarkComparison, len(comparisons))
+	copy(sorted, comparisons)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	var rows []metricRow
+	for _, comp := range sorted {
+		for _, metric := range comp.Metrics {
+			rows = append(rows, metricRow{benchmark: comp.Name, metric: metric})
+		}
+	}
+	return rows
+}
+
+// marshalMetrics converts metric comparisons to JSON-serializable format
+func marshalMetrics(metrics []*comparator.MetricComparison) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(metrics))
+	for _, metric := range metrics {
+		results = append(results, marshalMetric(metric))
+	}
+	return results
+}
+
+// marshalMetric converts a single metric comparison to JSON-serializable format
+func marshalMetric(metric *comparator.MetricComparison) map[string]interface{} {
+	return map[string]interface{}{
+		"name":              metric.Name,
+		"unit":              metric.Unit,
+		"baseline":          metric.Baseline,
+		"current":           metric.Current,
+		"delta_percent":     jsonFloat(metric.DeltaPercent),
+		"direction":         string(metric.Direction),
+		"threshold":         metric.Threshold,
+		"exceeds_threshold": metric.ExceedsThreshold,
+		"deterministic":     metric.Deterministic,
+		"statistical_test":  string(metric.Test),
+		"p_value":           metric.PValue,
+		"is_significant":    metric.IsSignificant,
+		"is_regression":     metric.IsRegression,
+	}
+}
+
+// metricPValueLabel formats the p-value of a metric, or "-" when it wasn't tested
+func metricPValueLabel(metric *comparator.MetricComparison) string {
+	if metric.Test == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%.4f", metric.PValue)
+}
+
+// formatMetricDelta formats a metric change, marking increases from zero as new
+func formatMetricDelta(metric *comparator.MetricComparison) string {
+	if math.IsInf(metric.DeltaPercent, 1) {
+		return "new"
+	}
+	return fmt.Sprintf("%+.2f%%", metric.DeltaPercent)
+}
+
+// metricThresholdLabel describes how much a metric may worsen before it is a regression
+func metricThresholdLabel(metric *comparator.MetricComparison) string {
+	if metric.Direction == comparator.HigherIsBetter {
+		if metric.Threshold <= 1 {
+			return "no decrease"
+		}
+		return fmt.Sprintf("-%.1f%%", (1-1/metric.Threshold)*100)
+	}
+	if metric.Threshold <= 1 {
+		return "no increase"
+	}
+	return fmt.Sprintf("+%.1f%%", (metric.Threshold-1)*100)
+}
+
+// hasSuiteChanges reports whether benchmarks were added, removed, renamed or skipped
+func hasSuiteChanges(result *comparator.ComparisonResult) bool {
+	return len(result.Added) > 0 || len(result.Removed) > 0 || len(result.Renamed) > 0 || len(result.LanguageMismatches) > 0
+}
+
+// writeHTMLList writes a titled list section, or nothing when items is empty
+func writeHTMLList(buf *bytes.Buffer, title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	buf.WriteString(fmt.Sprintf("\t\t<h2>%s</h2>\n\t\t<ul>\n", html.EscapeString(title)))
+	for _, item := range items {
+		buf.WriteString(fmt.Sprintf("\t\t\t<li><code>%s</code></li>\n", html.EscapeString(item)))
+	}
+	buf.WriteString("\t\t</ul>\n")
+}
+
+// marshalRenames converts renames to JSON-serializable format
+func marshalRenames(renames []comparator.Rename) []map[string]string {
+	results := make([]map[string]string, 0, len(renames))
+	for _, rename := range renames {
+		results = append(results, map[string]string{
+			"old_name": rename.Old,
+			"new_name": rename.New,
 		})
 	}
+	return results
+}
+
+// marshalLanguageMismatches converts language mismatches to JSON-serializable format
+func marshalLanguageMismatches(mismatches []comparator.LanguageMismatch) []map[string]string {
+	results := make([]map[string]string, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		results = append(results, map[string]string{
+			"name":              mismatch.Name,
+			"baseline_language": mismatch.BaselineLanguage,
+			"current_language":  mismatch.CurrentLanguage,
+		})
+	}
+	return results
+}
 
+// marshalEnvironmentMismatches converts environment mismatches to JSON-serializable format
+func marshalEnvironmentMismatches(mismatches []comparator.EnvironmentMismatch) []map[string]string {
+	results := make([]map[string]string, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		results = append(results, map[string]string{
+			"key":      mismatch.Key,
+			"baseline": mismatch.Baseline,
+			"current":  mismatch.Current,
+		})
+	}
 	return results
 }
+
+// nonNilStrings returns an empty slice instead of nil so JSON encodes [] rather than null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// formatMeasurement formats one side of a comparison in the benchmark's native unit:
+// throughput when it was compared (e.g. "1234567 ops/s"), time otherwise
+func formatMeasurement(comp *comparator.BenchmarkComparison, result *parser.BenchmarkResult) string {
+	if comp.Throughput != nil && result.Throughput != nil {
+		return fmt.Sprintf("%s %s", formatThroughputValue(result.Throughput.Value), result.Throughput.Unit)
+	}
+	return fmt.Sprintf("%d ns", result.Time.Nanoseconds())
+}
+
+// formatThroughputValue formats a throughput value with precision suited to its size
+func formatThroughputValue(v float64) string {
+	if v >= 100 {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.2f", v)
+}
+
+// formatDelta formats a benchmark's delta in its native unit. Throughput deltas are
+// labelled with their unit (e.g. "ops/s -8.00%"); time deltas include the bootstrap
+// confidence interval when one is available (e.g. "+4.2% [+1.1%, +7.5%]")
+func formatDelta(comp *comparator.BenchmarkComparison) string {
+	if comp.Throughput != nil {
+		return fmt.Sprintf("%s %+.2f%%", comp.Throughput.Unit, comp.Throughput.DeltaPercent)
+	}
+
+	lower, upper, ok := comp.DeltaCI()
+	if !ok {
+		return fmt.Sprintf("%.2f%%", comp.TimeDelta)
+	}
+	return fmt.Sprintf("%+.1f%% [%+.1f%%, %+.1f%%]", comp.TimeDelta, lower, upper)
+}
+
+// testLabel returns a human-readable name for a statistical test
+func testLabel(test comparator.StatisticalTest) string {
+	switch test {
+	case comparator.TestWelch:
+		return "Welch t"
+	case comparator.TestMannWhitney:
+		return "Mann–Whitney U"
+	case "":
+		return "n/a"
+	default:
+		return string(test)
+	}
+}
+
+// policyLabel describes the regression policy and the gates it applies
+func policyLabel(stats comparator.ComparisonStats) string {
+	threshold := fmt.Sprintf("delta > %.1f%%", (stats.RegressionThreshold-1)*100)
+	significance := fmt.Sprintf("p < %.2f", stats.SignificanceLevel)
+
+	var label string
+	switch stats.Policy {
+	case comparator.PolicyThreshold:
+		label = fmt.Sprintf("threshold (%s)", threshold)
+	case comparator.PolicySignificance:
+		label = fmt.Sprintf("significance (%s)", significance)
+	case comparator.PolicyBoth:
+		label = fmt.Sprintf("both (%s and %s)", threshold, significance)
+	default:
+		return "n/a"
+	}
+
+	if stats.MinEffectSize > 0 {
+		label += fmt.Sprintf(", |d| ≥ %.2f", stats.MinEffectSize)
+	}
+	return label
+}
+
+// correctionLabel returns a human-readable name for a multiple-comparison correction
+func correctionLabel(correction comparator.Correction) string {
+	switch correction {
+	case comparator.CorrectionBenjaminiHochberg:
+		return "Benjamini–Hochberg (FDR)"
+	case comparator.CorrectionHolm:
+		return "Holm–Bonferroni"
+	case comparator.CorrectionNone, "":
+		return "none"
+	default:
+		return string(correction)
+	}
+}
+
+// jsonFloat maps infinite values to nil so they can be encoded as JSON
+func jsonFloat(f float64) interface{} {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil
+	}
+	return f
+}
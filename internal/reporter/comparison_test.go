@@ -2,6 +2,7 @@ package reporter
 
 import (
 	"encoding/json"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -22,7 +23,7 @@ func createTestComparisonResult() *comparator.ComparisonResult {
 				IsRegression:        false,
 				IsSignificant:       true,
 				ConfidenceLevel:     0.95,
-				TTestPValue:         0.02,
+				PValue:              0.02,
 				EffectSize:          0.8,
 				RegressionThreshold: 1.05,
 			},
@@ -35,7 +36,7 @@ func createTestComparisonResult() *comparator.ComparisonResult {
 				IsRegression:        true,
 				IsSignificant:       true,
 				ConfidenceLevel:     0.95,
-				TTestPValue:         0.01,
+				PValue:              0.01,
 				EffectSize:          1.2,
 				RegressionThreshold: 1.05,
 			},
@@ -259,6 +260,17 @@ func TestGenerateJSON(t *testing.T) {
 	if _, ok := summary["improvements"]; !ok {
 		t.Error("JSON summary missing improvements")
 	}
+
+	// Check benchmark and statistics fields
+	benchmark := data["benchmarks"].([]interface{})[0].(map[string]interface{})
+	if _, ok := benchmark["adjusted_p_value"]; !ok {
+		t.Error("JSON benchmark missing adjusted_p_value")
+	}
+
+	statistics := data["statistics"].(map[string]interface{})
+	if _, ok := statistics["correction"]; !ok {
+		t.Error("JSON statistics missing correction")
+	}
 }
 
 func TestGenerateJSON_EmptyResult(t *testing.T) {
@@ -332,7 +344,7 @@ func TestMarshalBenchmarkComparisons(t *testing.T) {
 			TimeDelta:           10.0,
 			IsRegression:        true,
 			IsSignificant:       true,
-			TTestPValue:         0.01,
+			PValue:              0.01,
 			EffectSize:          0.5,
 			RegressionThreshold: 1.05,
 		},
@@ -356,4 +368,362 @@ func TestMarshalBenchmarkComparisons(t *testing.T) {
 	if comp["is_regression"] != true {
 		t.Errorf("is_regression = %v, want true", comp["is_regression"])
 	}
+
+	if comp["p_value"] != 0.01 || comp["t_test_p_value"] != 0.01 {
+		t.Errorf("p_value = %v, t_test_p_value = %v, want both 0.01", comp["p_value"], comp["t_test_p_value"])
+	}
+}
+
+func TestFormatDelta_WithConfidenceInterval(t *testing.T) {
+	result := createTestComparisonResult()
+	result.Benchmarks[1].TimeDelta = 4.2
+	result.Benchmarks[1].RatioCI = &comparator.ConfidenceInterval{Lower: 1.011, Upper: 1.075, Level: 0.95}
+
+	if got := formatDelta(result.Benchmarks[0]); got != "-5.00%" {
+		t.Errorf("formatDelta() without interval = %q, want %q", got, "-5.00%")
+	}
+
+	want := "+4.2% [+1.1%, +7.5%]"
+	if got := formatDelta(result.Benchmarks[1]); got != want {
+		t.Errorf("formatDelta() = %q, want %q", got, want)
+	}
+
+	reporter := NewBasicComparisonReporter()
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, want) {
+		t.Error("expected markdown to contain delta confidence interval")
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	benchmarks := data["benchmarks"].([]interface{})
+	if _, ok := benchmarks[0].(map[string]interface{})["delta_ci_lower_percent"]; ok {
+		t.Error("expected no interval for benchmark without samples")
+	}
+	lower, ok := benchmarks[1].(map[string]interface{})["delta_ci_lower_percent"].(float64)
+	if !ok || lower < 1.09 || lower > 1.11 {
+		t.Errorf("delta_ci_lower_percent = %v, want ~1.1", lower)
+	}
+}
+
+func TestGenerateReports_RegressionPolicy(t *testing.T) {
+	result := createTestComparisonResult()
+	result.Statistics.Policy = comparator.PolicyBoth
+	result.Statistics.MinEffectSize = 0.5
+	result.Benchmarks[1].RegressionReason = "exceeds 5.0% threshold, significant (p=0.0100)"
+
+	reporter := NewBasicComparisonReporter()
+
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, "**Regression Policy**: both (delta > 5.0% and p < 0.05), |d| ≥ 0.50") {
+		t.Error("expected markdown to describe the regression policy")
+	}
+	if !strings.Contains(markdown, "exceeds 5.0% threshold, significant (p=0.0100)") {
+		t.Error("expected markdown to include the regression reason")
+	}
+
+	htmlReport, err := reporter.GenerateHTML(result)
+	if err != nil {
+		t.Fatalf("GenerateHTML() error = %v", err)
+	}
+	if !strings.Contains(htmlReport, "<th>Reason</th>") || !strings.Contains(htmlReport, "Regression Policy") {
+		t.Error("expected HTML to include the regression policy and reasons")
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if data["statistics"].(map[string]interface{})["policy"] != "both" {
+		t.Error("expected JSON statistics to include the policy")
+	}
+	benchmarks := data["benchmarks"].([]interface{})
+	if _, ok := benchmarks[1].(map[string]interface{})["regression_reason"]; !ok {
+		t.Error("expected JSON benchmarks to include regression_reason")
+	}
+}
+
+func TestGenerateReports_ThresholdFallback(t *testing.T) {
+	result := createTestComparisonResult()
+	result.Statistics.Policy = comparator.PolicyBoth
+	result.Benchmarks[1].ThresholdFallback = true
+	result.Benchmarks[1].RegressionReason = "exceeds 5.0% threshold (no variance data for significance test, threshold used)"
+	result.Summary.ThresholdFallbacks = 1
+
+	reporter := NewBasicComparisonReporter()
+
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, "**Threshold Only (no variance data)**: 1") {
+		t.Error("expected markdown summary to count threshold fallbacks")
+	}
+
+	htmlReport, err := reporter.GenerateHTML(result)
+	if err != nil {
+		t.Fatalf("GenerateHTML() error = %v", err)
+	}
+	if !strings.Contains(htmlReport, "Threshold Only (no variance data)") {
+		t.Error("expected HTML summary to count threshold fallbacks")
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if data["summary"].(map[string]interface{})["threshold_fallbacks"] != 1.0 {
+		t.Error("expected JSON summary to include threshold_fallbacks")
+	}
+	benchmarks := data["benchmarks"].([]interface{})
+	if benchmarks[1].(map[string]interface{})["threshold_fallback"] != true {
+		t.Error("expected JSON benchmarks to include threshold_fallback")
+	}
+}
+
+func TestGenerateReports_Metrics(t *testing.T) {
+	result := createTestComparisonResult()
+	result.Statistics.MetricThresholds = comparator.DefaultMetricThresholds()
+	result.Benchmarks[0].Metrics = []*comparator.MetricComparison{
+		{Name: comparator.MetricAllocsPerOp, Unit: "allocs/op", Baseline: 10, Current: 12, DeltaPercent: 20, Threshold: 1.0, IsRegression: true},
+		{Name: comparator.MetricBytesPerOp, Unit: "B/op", Baseline: 0, Current: 64, DeltaPercent: math.Inf(1), Threshold: 1.05, IsRegression: true},
+	}
+
+	reporter := NewBasicComparisonReporter()
+
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, "## Metrics") {
+		t.Error("expected markdown metrics section")
+	}
+	if !strings.Contains(markdown, "| allocs/op | 10 | 12 | +20.00% | no increase | 🔴 |") {
+		t.Errorf("expected allocs/op row in markdown, got:\n%s", markdown)
+	}
+	if !strings.Contains(markdown, "| B/op | 0 | 64 | new | +5.0% | 🔴 |") {
+		t.Error("expected B/op row from zero in markdown")
+	}
+
+	htmlReport, err := reporter.GenerateHTML(result)
+	if err != nil {
+		t.Fatalf("GenerateHTML() error = %v", err)
+	}
+	if !strings.Contains(htmlReport, "<h2>Metrics</h2>") || !strings.Contains(htmlReport, "allocs/op") {
+		t.Error("expected HTML metrics table")
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	thresholds := data["statistics"].(map[string]interface{})["metric_thresholds"].(map[string]interface{})
+	if thresholds["allocs_per_op"] != 1.0 {
+		t.Errorf("expected allocs_per_op threshold 1.0, got %v", thresholds["allocs_per_op"])
+	}
+	benchmarks := data["benchmarks"].([]interface{})
+	metrics := benchmarks[0].(map[string]interface{})["metrics"].([]interface{})
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics in JSON, got %d", len(metrics))
+	}
+	if metrics[1].(map[string]interface{})["delta_percent"] != nil {
+		t.Error("expected infinite delta to be encoded as null")
+	}
+	if _, ok := benchmarks[1].(map[string]interface{})["metrics"]; ok {
+		t.Error("expected no metrics key for benchmarks without metrics")
+	}
+}
+
+func TestGenerateReports_NoisyMetric(t *testing.T) {
+	result := createTestComparisonResult()
+	result.Benchmarks[0].Metrics = []*comparator.MetricComparison{
+		{Name: "cpu_time", Unit: "ns/op", Baseline: 1000, Current: 1080, DeltaPercent: 8, Threshold: 1.05,
+			ExceedsThreshold: true, Test: comparator.TestWelch, PValue: 0.31},
+	}
+
+	reporter := NewBasicComparisonReporter()
+
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, "| ns/op | 1000 | 1080 | +8.00% | +5.0% | ⚠️ | 0.3100 |") {
+		t.Errorf("expected a warning for a noisy metric beyond its threshold, got:\n%s", markdown)
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	metric := data["benchmarks"].([]interface{})[0].(map[string]interface{})["metrics"].([]interface{})[0].(map[string]interface{})
+	if metric["exceeds_threshold"] != true || metric["is_regression"] != false || metric["p_value"] != 0.31 {
+		t.Errorf("unexpected metric JSON: %v", metric)
+	}
+}
+
+func TestGenerateReports_Throughput(t *testing.T) {
+	result := createTestComparisonResult()
+	comp := result.Benchmarks[0]
+	comp.Baseline.Throughput = &parser.Throughput{Value: 1000000, Unit: "ops/s"}
+	comp.Current.Throughput = &parser.Throughput{Value: 920000, Unit: "ops/s"}
+	comp.Throughput = &comparator.MetricComparison{
+		Name: comparator.MetricThroughput, Unit: "ops/s", Baseline: 1000000, Current: 920000,
+		DeltaPercent: -8, Direction: comparator.HigherIsBetter, Threshold: 1.05, IsRegression: true,
+	}
+
+	reporter := NewBasicComparisonReporter()
+
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, "| 1000000 ops/s | 920000 ops/s | ops/s -8.00% |") {
+		t.Errorf("expected throughput in native units, got:\n%s", markdown)
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	benchmarks := data["benchmarks"].([]interface{})
+	throughput, ok := benchmarks[0].(map[string]interface{})["throughput"].(map[string]interface{})
+	if !ok {
+		t.Fatal("expected throughput object in JSON")
+	}
+	if throughput["direction"] != "higher" || throughput["delta_percent"] != -8.0 {
+		t.Errorf("unexpected throughput JSON: %v", throughput)
+	}
+}
+
+func TestGenerateReports_SuiteChanges(t *testing.T) {
+	result := createTestComparisonResult()
+	result.Added = []string{"parse"}
+	result.Removed = []string{"legacy"}
+	result.Renamed = []comparator.Rename{{Old: "find", New: "search"}}
+	result.LanguageMismatches = []comparator.LanguageMismatch{{Name: "hash", BaselineLanguage: "rust", CurrentLanguage: "go"}}
+
+	reporter := NewBasicComparisonReporter()
+
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	for _, want := range []string{"Added Benchmarks", "`parse`", "Removed Benchmarks", "`legacy`", "`find` → `search`", "`hash`: rust → go"} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("markdown missing %q", want)
+		}
+	}
+
+	htmlReport, err := reporter.GenerateHTML(result)
+	if err != nil {
+		t.Fatalf("GenerateHTML() error = %v", err)
+	}
+	for _, want := range []string{"Added Benchmarks", "Removed Benchmarks", "find → search", "Language Mismatches"} {
+		if !strings.Contains(htmlReport, want) {
+			t.Errorf("HTML missing %q", want)
+		}
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if added := data["added"].([]interface{}); len(added) != 1 || added[0] != "parse" {
+		t.Errorf("added = %v", added)
+	}
+	renamed := data["renamed"].([]interface{})[0].(map[string]interface{})
+	if renamed["old_name"] != "find" || renamed["new_name"] != "search" {
+		t.Errorf("renamed = %v", renamed)
+	}
+	mismatch := data["language_mismatches"].([]interface{})[0].(map[string]interface{})
+	if mismatch["current_language"] != "go" {
+		t.Errorf("language_mismatches = %v", mismatch)
+	}
+}
+
+func TestGenerateReports_EnvironmentMismatches(t *testing.T) {
+	result := createTestComparisonResult()
+	result.EnvironmentMismatches = []comparator.EnvironmentMismatch{{Key: "cpu", Baseline: "Intel Core i9", Current: "Apple M1"}}
+
+	reporter := NewBasicComparisonReporter()
+
+	markdown, err := reporter.GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, "Environment Mismatches") || !strings.Contains(markdown, "**cpu**: `Intel Core i9` → `Apple M1`") {
+		t.Errorf("markdown missing environment mismatch:\n%s", markdown)
+	}
+
+	htmlReport, err := reporter.GenerateHTML(result)
+	if err != nil {
+		t.Fatalf("GenerateHTML() error = %v", err)
+	}
+	if !strings.Contains(htmlReport, "Environment Mismatches") || !strings.Contains(htmlReport, "cpu differs: &#34;Intel Core i9&#34; → &#34;Apple M1&#34;") {
+		t.Error("HTML missing environment mismatch")
+	}
+
+	jsonStr, err := reporter.GenerateJSON(result)
+	if err != nil {
+		t.Fatalf("GenerateJSON() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	mismatch := data["environment_mismatches"].([]interface{})[0].(map[string]interface{})
+	if mismatch["key"] != "cpu" || mismatch["current"] != "Apple M1" {
+		t.Errorf("environment_mismatches = %v", mismatch)
+	}
+}
+
+func TestGenerateMarkdown_OnlySuiteChanges(t *testing.T) {
+	result := &comparator.ComparisonResult{Removed: []string{"legacy"}}
+
+	markdown, err := NewBasicComparisonReporter().GenerateMarkdown(result)
+	if err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	if !strings.Contains(markdown, "`legacy`") {
+		t.Error("expected removed benchmarks to be reported without comparisons")
+	}
+	if strings.Contains(markdown, "Detailed Results") {
+		t.Error("expected no results table without comparisons")
+	}
 }
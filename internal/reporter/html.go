@@ -16,19 +16,26 @@ var templateFS embed.FS
 
 // HTMLReporter generates HTML reports with embedded CSS and JavaScript
 type HTMLReporter struct {
-	templates *template.Template
+	templates map[string]*template.Template
 }
 
+// pageTemplates lists the report pages; each defines its own "content" block
+var pageTemplates = []string{"summary.html", "comparison.html", "trend.html"}
+
 // NewHTMLReporter creates a new HTML reporter
 func NewHTMLReporter() (*HTMLReporter, error) {
-	// Parse all templates
-	tmpl, err := template.New("").Funcs(templateFuncs()).ParseFS(templateFS, "templates/*.html")
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse templates: %w", err)
+	// Parse each page together with the base layout so their "content" blocks don't collide
+	templates := make(map[string]*template.Template, len(pageTemplates))
+	for _, page := range pageTemplates {
+		tmpl, err := template.New(page).Funcs(templateFuncs()).ParseFS(templateFS, "templates/base.html", "templates/"+page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse templates: %w", err)
+		}
+		templates[page] = tmpl
 	}
 
 	return &HTMLReporter{
-		templates: tmpl,
+		templates: templates,
 	}, nil
 }
 
@@ -61,7 +68,7 @@ func (r *HTMLReporter) GenerateSummary(suite *aggregator.AggregatedSuite, opts *
 	}
 
 	// Execute template
-	if err := r.templates.ExecuteTemplate(writer, "summary.html", data); err != nil {
+	if err := r.templates["summary.html"].ExecuteTemplate(writer, "summary.html", data); err != nil {
 		return fmt.Errorf("failed to execute template: %w", err)
 	}
 
@@ -97,7 +104,7 @@ func (r *HTMLReporter) GenerateComparison(comparison *aggregator.ComparisonSuite
 	}
 
 	// Execute template
-	if err := r.templates.ExecuteTemplate(writer, "comparison.html", data); err != nil {
+	if err := r.templates["comparison.html"].ExecuteTemplate(writer, "comparison.html", data); err != nil {
 		return fmt.Errorf("failed to execute template: %w", err)
 	}
 
@@ -133,7 +140,7 @@ func (r *HTMLReporter) GenerateTrend(history []*aggregator.AggregatedResult, opt
 	}
 
 	// Execute template
-	if err := r.templates.ExecuteTemplate(writer, "trend.html", data); err != nil {
+	if err := r.templates["trend.html"].ExecuteTemplate(writer, "trend.html", data); err != nil {
 		return fmt.Errorf("failed to execute template: %w", err)
 	}
 
@@ -90,6 +90,84 @@ func TestHTMLReporter_GenerateSummary_Success(t *testing.T) {
 	}
 }
 
+func TestHTMLReporter_GenerateSummary_MemoryColumns(t *testing.T) {
+	reporter, err := NewHTMLReporter()
+	if err != nil {
+		t.Fatalf("failed to create reporter: %v", err)
+	}
+
+	allocs := int64(7)
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "BenchmarkEncode", Language: "go", Mean: 100 * time.Nanosecond, AllocsPerOp: &allocs},
+		},
+		Timestamp: time.Now(),
+		Stats:     &aggregator.SuiteStats{TotalBenchmarks: 1},
+	}
+
+	var buf bytes.Buffer
+	if err := reporter.GenerateSummary(suite, &ReportOptions{ShowDetails: true}, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "<th>Allocs/op</th>") || !strings.Contains(output, "<td>7</td>") {
+		t.Error("expected memory columns in summary")
+	}
+
+	suite.Results[0].AllocsPerOp = nil
+	buf.Reset()
+	if err := reporter.GenerateSummary(suite, &ReportOptions{ShowDetails: true}, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "<th>Allocs/op</th>") {
+		t.Error("expected no memory columns without memory metrics")
+	}
+}
+
+func TestHTMLReporter_GenerateSummary_Environment(t *testing.T) {
+	reporter, err := NewHTMLReporter()
+	if err != nil {
+		t.Fatalf("failed to create reporter: %v", err)
+	}
+
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "BenchmarkEncode", Language: "go", Package: "example.com/codec", Mean: 100 * time.Nanosecond},
+		},
+		Metadata: map[string]string{
+			"goos":    "linux",
+			"goarch":  "amd64",
+			"cpu":     "AMD EPYC 7763 64-Core Processor",
+			"package": "example.com/codec",
+		},
+		Timestamp: time.Now(),
+		Stats:     &aggregator.SuiteStats{TotalBenchmarks: 1},
+	}
+
+	var buf bytes.Buffer
+	if err := reporter.GenerateSummary(suite, &ReportOptions{ShowDetails: true}, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"<h2>Environment</h2>", "<td>amd64</td>", "<td>AMD EPYC 7763 64-Core Processor</td>", "<th>Package</th>", "<td>example.com/codec</td>"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in summary", want)
+		}
+	}
+
+	suite.Metadata = nil
+	suite.Results[0].Package = ""
+	buf.Reset()
+	if err := reporter.GenerateSummary(suite, &ReportOptions{ShowDetails: true}, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "<h2>Environment</h2>") || strings.Contains(buf.String(), "<th>Package</th>") {
+		t.Error("expected no environment section or package column without metadata")
+	}
+}
+
 func TestHTMLReporter_GenerateSummary_NilSuite(t *testing.T) {
 	reporter, _ := NewHTMLReporter()
 
@@ -137,6 +215,34 @@ func TestHTMLReporter_GenerateSummary_WithoutCharts(t *testing.T) {
 	}
 }
 
+func TestHTMLReporter_GenerateSummary_Theme(t *testing.T) {
+	reporter, _ := NewHTMLReporter()
+
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "bench_test", Language: "rust", Mean: 100 * time.Millisecond},
+		},
+		Timestamp: time.Now(),
+		Stats:     &aggregator.SuiteStats{TotalBenchmarks: 1},
+	}
+
+	var light bytes.Buffer
+	if err := reporter.GenerateSummary(suite, &ReportOptions{DarkMode: false}, &light); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(light.String(), "#F5F6FA") {
+		t.Error("expected light theme when DarkMode is false")
+	}
+
+	var dark bytes.Buffer
+	if err := reporter.GenerateSummary(suite, &ReportOptions{DarkMode: true}, &dark); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(dark.String(), "#F5F6FA") {
+		t.Error("expected no light theme when DarkMode is true")
+	}
+}
+
 func TestHTMLReporter_GenerateComparison_Success(t *testing.T) {
 	reporter, _ := NewHTMLReporter()
 
@@ -96,17 +96,60 @@
 //	    suite_id INTEGER NOT NULL,
 //	    name TEXT NOT NULL,
 //	    language TEXT NOT NULL,
+//	    package TEXT NOT NULL DEFAULT '',  -- e.g. Go import path; empty when unknown
+//	    group_name TEXT NOT NULL DEFAULT '', -- benchmark group; empty when unknown
+//	    params TEXT NOT NULL DEFAULT '',  -- JSON parameters; empty when not parameterized
 //	    mean INTEGER NOT NULL,
 //	    median INTEGER NOT NULL,
 //	    min INTEGER NOT NULL,
 //	    max INTEGER NOT NULL,
 //	    stddev INTEGER NOT NULL,
 //	    iterations INTEGER NOT NULL,
+//	    bytes_per_op INTEGER,           -- NULL when not measured
+//	    allocs_per_op INTEGER,          -- NULL when not measured
 //	    timestamp DATETIME NOT NULL,
 //	    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 //	    FOREIGN KEY (suite_id) REFERENCES suites(id) ON DELETE CASCADE
 //	);
 //
+// Memory, package and label columns added after the initial schema are created on
+// Init for existing databases, so older files keep working. The suite metadata
+// JSON keeps the environment the run reported (goos, goarch, cpu, package).
+//
+// ## samples table
+//
+// Raw per-run samples for results that carry them (Go -count, pytest-benchmark
+// --benchmark-save-data, Criterion sample.json):
+//
+//	CREATE TABLE samples (
+//	    id INTEGER PRIMARY KEY AUTOINCREMENT,
+//	    result_id INTEGER NOT NULL,
+//	    sample_index INTEGER NOT NULL,
+//	    value INTEGER NOT NULL,
+//	    FOREIGN KEY (result_id) REFERENCES results(id) ON DELETE CASCADE
+//	);
+//
+// ## metrics table
+//
+// Named metrics other than time and memory (throughput, instructions, cache
+// misses, custom b.ReportMetric values), one row per result and metric:
+//
+//	CREATE TABLE metrics (
+//	    id INTEGER PRIMARY KEY AUTOINCREMENT,
+//	    result_id INTEGER NOT NULL,
+//	    name TEXT NOT NULL,
+//	    unit TEXT NOT NULL,
+//	    higher_is_better INTEGER NOT NULL DEFAULT 0,
+//	    deterministic INTEGER NOT NULL DEFAULT 0,  -- 1 for counts without noise
+//	    mean REAL NOT NULL,
+//	    median REAL NOT NULL,
+//	    min REAL NOT NULL,
+//	    max REAL NOT NULL,
+//	    stddev REAL NOT NULL,
+//	    count INTEGER NOT NULL,
+//	    FOREIGN KEY (result_id) REFERENCES results(id) ON DELETE CASCADE
+//	);
+//
 // # Indexes
 //
 // The following indexes are created for query optimization:
@@ -115,6 +158,9 @@
 //   - results.suite_id - Fast join with suites
 //   - results.name - Fast benchmark history queries
 //   - results.timestamp - Fast time-based queries
+//   - samples.result_id - Fast sample loading per result
+//   - metrics.result_id - Fast metric loading per result
+//   - metrics.name - Fast lookups of a metric across runs
 //
 // # Data Model
 //
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
@@ -102,7 +103,7 @@ func (qo *QueryOptimizer) GetHistoryOptimized(benchmarkName string, limit, offse
 
 	// Query database with pagination
 	query := `
-		SELECT name, language, mean, median, min, max, stddev, iterations, timestamp
+		SELECT name, language, package, mean, median, min, max, stddev, iterations, bytes_per_op, allocs_per_op, timestamp
 		FROM results
 		WHERE name = ?
 		ORDER BY timestamp DESC
@@ -120,16 +121,20 @@ func (qo *QueryOptimizer) GetHistoryOptimized(benchmarkName string, limit, offse
 	for rows.Next() {
 		var r aggregator.AggregatedResult
 		var mean, median, min, max, stddev, iterations int64
+		var bytesPerOp, allocsPerOp sql.NullInt64
 
 		err := rows.Scan(
 			&r.Name,
 			&r.Language,
+			&r.Package,
 			&mean,
 			&median,
 			&min,
 			&max,
 			&stddev,
 			&iterations,
+			&bytesPerOp,
+			&allocsPerOp,
 			&r.Timestamp,
 		)
 		if err != nil {
@@ -142,6 +147,8 @@ func (qo *QueryOptimizer) GetHistoryOptimized(benchmarkName string, limit, offse
 		r.Max = time.Duration(max)
 		r.StdDev = time.Duration(stddev)
 		r.Iterations = iterations
+		r.BytesPerOp = nullableInt64(bytesPerOp)
+		r.AllocsPerOp = nullableInt64(allocsPerOp)
 
 		results = append(results, &r)
 	}
@@ -326,12 +333,15 @@ func (qc *QueryCache) MaxSize() int {
 func loadSuiteOptimized(db *sql.DB, stored *StoredSuite, metadataJSON string) (*aggregator.AggregatedSuite, error) {
 	// Deserialize metadata
 	var metadata map[string]string
-	// Note: In production, this would use json.Unmarshal to parse metadataJSON
-	// For now, initialize empty map
+	if metadataJSON != "" {
+		if err := json.Unmarshal([]byte(metadataJSON), &metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+		}
+	}
 
 	// Load results with optimized query
 	rows, err := db.Query(`
-		SELECT name, language, mean, median, min, max, stddev, iterations, timestamp
+		SELECT id, name, language, package, group_name, params, mean, median, min, max, stddev, iterations, bytes_per_op, allocs_per_op, timestamp
 		FROM results
 		WHERE suite_id = ?
 		ORDER BY name
@@ -342,20 +352,29 @@ func loadSuiteOptimized(db *sql.DB, stored *StoredSuite, metadataJSON string) (*
 	defer func() { _ = rows.Close() }()
 
 	var results []*aggregator.AggregatedResult
+	var resultIDs []int64
 
 	for rows.Next() {
 		var r aggregator.AggregatedResult
-		var mean, median, min, max, stddev, iterations int64
+		var id, mean, median, min, max, stddev, iterations int64
+		var bytesPerOp, allocsPerOp sql.NullInt64
+		var params string
 
 		err := rows.Scan(
+			&id,
 			&r.Name,
 			&r.Language,
+			&r.Package,
+			&r.Group,
+			&params,
 			&mean,
 			&median,
 			&min,
 			&max,
 			&stddev,
 			&iterations,
+			&bytesPerOp,
+			&allocsPerOp,
 			&r.Timestamp,
 		)
 		if err != nil {
@@ -368,14 +387,34 @@ func loadSuiteOptimized(db *sql.DB, stored *StoredSuite, metadataJSON string) (*
 		r.Max = time.Duration(max)
 		r.StdDev = time.Duration(stddev)
 		r.Iterations = iterations
+		r.BytesPerOp = nullableInt64(bytesPerOp)
+		r.AllocsPerOp = nullableInt64(allocsPerOp)
+		if r.Params, err = unmarshalParams(params); err != nil {
+			return nil, err
+		}
 
 		results = append(results, &r)
+		resultIDs = append(resultIDs, id)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating results: %w", err)
 	}
 
+	// Attach raw samples and named metrics
+	samples, err := loadSuiteSamples(db, stored.ID)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := loadSuiteMetrics(db, stored.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range results {
+		r.Samples = samples[resultIDs[i]]
+		r.Metrics = metrics[resultIDs[i]]
+	}
+
 	suite := &aggregator.AggregatedSuite{
 		Results:   results,
 		Metadata:  metadata,
@@ -49,12 +49,17 @@ func (s *SQLiteStorage) Init() error {
 		suite_id INTEGER NOT NULL,
 		name TEXT NOT NULL,
 		language TEXT NOT NULL,
+		package TEXT NOT NULL DEFAULT '',
+		group_name TEXT NOT NULL DEFAULT '',
+		params TEXT NOT NULL DEFAULT '',
 		mean INTEGER NOT NULL,
 		median INTEGER NOT NULL,
 		min INTEGER NOT NULL,
 		max INTEGER NOT NULL,
 		stddev INTEGER NOT NULL,
 		iterations INTEGER NOT NULL,
+		bytes_per_op INTEGER,
+		allocs_per_op INTEGER,
 		timestamp DATETIME NOT NULL,
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 		FOREIGN KEY (suite_id) REFERENCES suites(id) ON DELETE CASCADE
@@ -63,12 +68,58 @@ func (s *SQLiteStorage) Init() error {
 	CREATE INDEX IF NOT EXISTS idx_results_suite_id ON results(suite_id);
 	CREATE INDEX IF NOT EXISTS idx_results_name ON results(name);
 	CREATE INDEX IF NOT EXISTS idx_results_timestamp ON results(timestamp);
+
+	CREATE TABLE IF NOT EXISTS samples (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		result_id INTEGER NOT NULL,
+		sample_index INTEGER NOT NULL,
+		value INTEGER NOT NULL,
+		FOREIGN KEY (result_id) REFERENCES results(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_samples_result_id ON samples(result_id);
+
+	CREATE TABLE IF NOT EXISTS metrics (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		result_id INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		unit TEXT NOT NULL,
+		higher_is_better INTEGER NOT NULL DEFAULT 0,
+		deterministic INTEGER NOT NULL DEFAULT 0,
+		mean REAL NOT NULL,
+		median REAL NOT NULL,
+		min REAL NOT NULL,
+		max REAL NOT NULL,
+		stddev REAL NOT NULL,
+		count INTEGER NOT NULL,
+		FOREIGN KEY (result_id) REFERENCES results(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_metrics_result_id ON metrics(result_id);
+	CREATE INDEX IF NOT EXISTS idx_metrics_name ON metrics(name);
 	`
 
 	if _, err := s.db.Exec(schema); err != nil {
 		return fmt.Errorf("failed to create schema: %w", err)
 	}
 
+	// Add columns introduced after the initial schema to existing databases
+	for _, column := range []struct{ name, definition string }{
+		{"bytes_per_op", "INTEGER"},
+		{"allocs_per_op", "INTEGER"},
+		{"package", "TEXT NOT NULL DEFAULT ''"},
+		{"group_name", "TEXT NOT NULL DEFAULT ''"},
+		{"params", "TEXT NOT NULL DEFAULT ''"},
+	} {
+		if err := s.ensureColumn("results", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	if err := s.ensureColumn("metrics", "deterministic", "INTEGER NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
 	// Initialize comparison history tables
 	if err := s.InitComparisonHistory(); err != nil {
 		return fmt.Errorf("failed to init comparison history: %w", err)
@@ -77,6 +128,69 @@ func (s *SQLiteStorage) Init() error {
 	return nil
 }
 
+// ensureColumn adds a column to a table if it does not exist yet
+func (s *SQLiteStorage) ensureColumn(table, column, definition string) error {
+	rows, err := s.db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("failed to inspect table %s: %w", table, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, colType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultValue, &pk); err != nil {
+			return fmt.Errorf("failed to scan table info: %w", err)
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating table info: %w", err)
+	}
+
+	if _, err := s.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", table, column, err)
+	}
+
+	return nil
+}
+
+// nullableInt64 converts a nullable column value to an optional integer
+func nullableInt64(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	value := v.Int64
+	return &value
+}
+
+// marshalParams serializes benchmark parameters as JSON, or "" when there are none
+func marshalParams(params map[string]string) (string, error) {
+	if len(params) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal params: %w", err)
+	}
+	return string(data), nil
+}
+
+// unmarshalParams deserializes benchmark parameters stored by marshalParams
+func unmarshalParams(data string) (map[string]string, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var params map[string]string
+	if err := json.Unmarshal([]byte(data), &params); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+	return params, nil
+}
+
 // Close closes the database connection
 func (s *SQLiteStorage) Close() error {
 	if s.db != nil {
@@ -87,20 +201,26 @@ func (s *SQLiteStorage) Close() error {
 
 // Save saves an aggregated suite to storage
 func (s *SQLiteStorage) Save(suite *aggregator.AggregatedSuite) error {
+	_, err := s.SaveSuite(suite)
+	return err
+}
+
+// SaveSuite saves an aggregated suite to storage and returns the ID assigned to it
+func (s *SQLiteStorage) SaveSuite(suite *aggregator.AggregatedSuite) (int64, error) {
 	if suite == nil {
-		return fmt.Errorf("suite cannot be nil")
+		return 0, fmt.Errorf("suite cannot be nil")
 	}
 
 	tx, err := s.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }()
 
 	// Serialize metadata
 	metadataJSON, err := json.Marshal(suite.Metadata)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return 0, fmt.Errorf("failed to marshal metadata: %w", err)
 	}
 
 	// Insert suite
@@ -109,47 +229,96 @@ func (s *SQLiteStorage) Save(suite *aggregator.AggregatedSuite) error {
 		VALUES (?, ?, ?)
 	`, suite.Timestamp, suite.Duration.Nanoseconds(), string(metadataJSON))
 	if err != nil {
-		return fmt.Errorf("failed to insert suite: %w", err)
+		return 0, fmt.Errorf("failed to insert suite: %w", err)
 	}
 
 	suiteID, err := result.LastInsertId()
 	if err != nil {
-		return fmt.Errorf("failed to get suite ID: %w", err)
+		return 0, fmt.Errorf("failed to get suite ID: %w", err)
 	}
 
 	// Insert results
 	stmt, err := tx.Prepare(`
-		INSERT INTO results (suite_id, name, language, mean, median, min, max, stddev, iterations, timestamp)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO results (suite_id, name, language, package, group_name, params, mean, median, min, max, stddev, iterations, bytes_per_op, allocs_per_op, timestamp)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`)
 	if err != nil {
-		return fmt.Errorf("failed to prepare statement: %w", err)
+		return 0, fmt.Errorf("failed to prepare statement: %w", err)
 	}
 	defer func() { _ = stmt.Close() }()
 
+	sampleStmt, err := tx.Prepare(`
+		INSERT INTO samples (result_id, sample_index, value)
+		VALUES (?, ?, ?)
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare sample statement: %w", err)
+	}
+	defer func() { _ = sampleStmt.Close() }()
+
+	metricStmt, err := tx.Prepare(`
+		INSERT INTO metrics (result_id, name, unit, higher_is_better, deterministic, mean, median, min, max, stddev, count)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare metric statement: %w", err)
+	}
+	defer func() { _ = metricStmt.Close() }()
+
 	for _, r := range suite.Results {
-		_, err := stmt.Exec(
+		params, err := marshalParams(r.Params)
+		if err != nil {
+			return 0, err
+		}
+
+		res, err := stmt.Exec(
 			suiteID,
 			r.Name,
 			r.Language,
+			r.Package,
+			r.Group,
+			params,
 			r.Mean.Nanoseconds(),
 			r.Median.Nanoseconds(),
 			r.Min.Nanoseconds(),
 			r.Max.Nanoseconds(),
 			r.StdDev.Nanoseconds(),
 			r.Iterations,
+			r.BytesPerOp,
+			r.AllocsPerOp,
 			r.Timestamp,
 		)
 		if err != nil {
-			return fmt.Errorf("failed to insert result: %w", err)
+			return 0, fmt.Errorf("failed to insert result: %w", err)
+		}
+
+		if len(r.Samples) == 0 && len(r.Metrics) == 0 {
+			continue
+		}
+
+		resultID, err := res.LastInsertId()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get result ID: %w", err)
+		}
+
+		for i, sample := range r.Samples {
+			if _, err := sampleStmt.Exec(resultID, i, sample.Nanoseconds()); err != nil {
+				return 0, fmt.Errorf("failed to insert sample: %w", err)
+			}
+		}
+
+		for _, m := range r.Metrics {
+			if _, err := metricStmt.Exec(resultID, m.Name, m.Unit, m.HigherIsBetter, m.Deterministic, m.Mean, m.Median, m.Min, m.Max, m.StdDev, m.Count); err != nil {
+				return 0, fmt.Errorf("failed to insert metric: %w", err)
+			}
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return nil
+	return suiteID, nil
 }
 
 // GetLatest retrieves the most recent suite
@@ -198,6 +367,28 @@ func (s *SQLiteStorage) GetByTimestamp(timestamp time.Time) (*aggregator.Aggrega
 	return s.loadSuite(&stored, metadataJSON)
 }
 
+// GetByID retrieves a suite by its storage ID
+func (s *SQLiteStorage) GetByID(id int64) (*aggregator.AggregatedSuite, error) {
+	row := s.db.QueryRow(`
+		SELECT id, timestamp, duration, metadata
+		FROM suites
+		WHERE id = ?
+	`, id)
+
+	var stored StoredSuite
+	var metadataJSON string
+
+	err := row.Scan(&stored.ID, &stored.Timestamp, &stored.Duration, &metadataJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query suite by ID: %w", err)
+	}
+
+	return s.loadSuite(&stored, metadataJSON)
+}
+
 // GetRange retrieves suites within a time range
 func (s *SQLiteStorage) GetRange(start, end time.Time) ([]*aggregator.AggregatedSuite, error) {
 	rows, err := s.db.Query(`
@@ -239,7 +430,7 @@ func (s *SQLiteStorage) GetRange(start, end time.Time) ([]*aggregator.Aggregated
 // GetHistory retrieves all suites for a specific benchmark
 func (s *SQLiteStorage) GetHistory(benchmarkName string, limit int) ([]*aggregator.AggregatedResult, error) {
 	query := `
-		SELECT name, language, mean, median, min, max, stddev, iterations, timestamp
+		SELECT name, language, package, mean, median, min, max, stddev, iterations, bytes_per_op, allocs_per_op, timestamp
 		FROM results
 		WHERE name = ?
 		ORDER BY timestamp DESC
@@ -260,16 +451,20 @@ func (s *SQLiteStorage) GetHistory(benchmarkName string, limit int) ([]*aggregat
 	for rows.Next() {
 		var r aggregator.AggregatedResult
 		var mean, median, min, max, stddev, iterations int64
+		var bytesPerOp, allocsPerOp sql.NullInt64
 
 		err := rows.Scan(
 			&r.Name,
 			&r.Language,
+			&r.Package,
 			&mean,
 			&median,
 			&min,
 			&max,
 			&stddev,
 			&iterations,
+			&bytesPerOp,
+			&allocsPerOp,
 			&r.Timestamp,
 		)
 		if err != nil {
@@ -282,6 +477,8 @@ func (s *SQLiteStorage) GetHistory(benchmarkName string, limit int) ([]*aggregat
 		r.Max = time.Duration(max)
 		r.StdDev = time.Duration(stddev)
 		r.Iterations = iterations
+		r.BytesPerOp = nullableInt64(bytesPerOp)
+		r.AllocsPerOp = nullableInt64(allocsPerOp)
 
 		results = append(results, &r)
 	}
@@ -301,21 +498,54 @@ func (s *SQLiteStorage) Cleanup(retentionDays int) error {
 
 	cutoff := time.Now().AddDate(0, 0, -retentionDays)
 
-	result, err := s.db.Exec(`
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Foreign key cascades are not enabled on the connection, so remove
+	// dependent rows explicitly before the suites themselves
+	if _, err := tx.Exec(`
+		DELETE FROM samples
+		WHERE result_id IN (
+			SELECT r.id FROM results r
+			JOIN suites s ON s.id = r.suite_id
+			WHERE s.timestamp < ?
+		)
+	`, cutoff); err != nil {
+		return fmt.Errorf("failed to cleanup old samples: %w", err)
+	}
+
+	if _, err := tx.Exec(`
+		DELETE FROM metrics
+		WHERE result_id IN (
+			SELECT r.id FROM results r
+			JOIN suites s ON s.id = r.suite_id
+			WHERE s.timestamp < ?
+		)
+	`, cutoff); err != nil {
+		return fmt.Errorf("failed to cleanup old metrics: %w", err)
+	}
+
+	if _, err := tx.Exec(`
+		DELETE FROM results
+		WHERE suite_id IN (SELECT id FROM suites WHERE timestamp < ?)
+	`, cutoff); err != nil {
+		return fmt.Errorf("failed to cleanup old results: %w", err)
+	}
+
+	if _, err := tx.Exec(`
 		DELETE FROM suites
 		WHERE timestamp < ?
-	`, cutoff)
-	if err != nil {
+	`, cutoff); err != nil {
 		return fmt.Errorf("failed to cleanup old records: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get affected rows: %w", err)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit cleanup: %w", err)
 	}
 
-	_ = rowsAffected // Optionally log this
-
 	return nil
 }
 
@@ -331,7 +561,7 @@ func (s *SQLiteStorage) loadSuite(stored *StoredSuite, metadataJSON string) (*ag
 
 	// Load results
 	rows, err := s.db.Query(`
-		SELECT name, language, mean, median, min, max, stddev, iterations, timestamp
+		SELECT id, name, language, package, group_name, params, mean, median, min, max, stddev, iterations, bytes_per_op, allocs_per_op, timestamp
 		FROM results
 		WHERE suite_id = ?
 		ORDER BY name
@@ -342,20 +572,29 @@ func (s *SQLiteStorage) loadSuite(stored *StoredSuite, metadataJSON string) (*ag
 	defer func() { _ = rows.Close() }()
 
 	var results []*aggregator.AggregatedResult
+	var resultIDs []int64
 
 	for rows.Next() {
 		var r aggregator.AggregatedResult
-		var mean, median, min, max, stddev, iterations int64
+		var id, mean, median, min, max, stddev, iterations int64
+		var bytesPerOp, allocsPerOp sql.NullInt64
+		var params string
 
 		err := rows.Scan(
+			&id,
 			&r.Name,
 			&r.Language,
+			&r.Package,
+			&r.Group,
+			&params,
 			&mean,
 			&median,
 			&min,
 			&max,
 			&stddev,
 			&iterations,
+			&bytesPerOp,
+			&allocsPerOp,
 			&r.Timestamp,
 		)
 		if err != nil {
@@ -368,14 +607,34 @@ func (s *SQLiteStorage) loadSuite(stored *StoredSuite, metadataJSON string) (*ag
 		r.Max = time.Duration(max)
 		r.StdDev = time.Duration(stddev)
 		r.Iterations = iterations
+		r.BytesPerOp = nullableInt64(bytesPerOp)
+		r.AllocsPerOp = nullableInt64(allocsPerOp)
+		if r.Params, err = unmarshalParams(params); err != nil {
+			return nil, err
+		}
 
 		results = append(results, &r)
+		resultIDs = append(resultIDs, id)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating results: %w", err)
 	}
 
+	// Attach raw samples and named metrics
+	samples, err := loadSuiteSamples(s.db, stored.ID)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := loadSuiteMetrics(s.db, stored.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range results {
+		r.Samples = samples[resultIDs[i]]
+		r.Metrics = metrics[resultIDs[i]]
+	}
+
 	suite := &aggregator.AggregatedSuite{
 		Results:   results,
 		Metadata:  metadata,
@@ -391,6 +650,69 @@ func (s *SQLiteStorage) loadSuite(stored *StoredSuite, metadataJSON string) (*ag
 	return suite, nil
 }
 
+// loadSuiteSamples loads raw samples for all results in a suite, keyed by result ID
+func loadSuiteSamples(db *sql.DB, suiteID int64) (map[int64][]time.Duration, error) {
+	rows, err := db.Query(`
+		SELECT s.result_id, s.value
+		FROM samples s
+		JOIN results r ON r.id = s.result_id
+		WHERE r.suite_id = ?
+		ORDER BY s.result_id, s.sample_index
+	`, suiteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query samples: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	samples := make(map[int64][]time.Duration)
+
+	for rows.Next() {
+		var resultID, value int64
+		if err := rows.Scan(&resultID, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan sample: %w", err)
+		}
+		samples[resultID] = append(samples[resultID], time.Duration(value))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating samples: %w", err)
+	}
+
+	return samples, nil
+}
+
+// loadSuiteMetrics loads named metrics for all results in a suite, keyed by result ID
+func loadSuiteMetrics(db *sql.DB, suiteID int64) (map[int64][]*aggregator.AggregatedMetric, error) {
+	rows, err := db.Query(`
+		SELECT m.result_id, m.name, m.unit, m.higher_is_better, m.deterministic, m.mean, m.median, m.min, m.max, m.stddev, m.count
+		FROM metrics m
+		JOIN results r ON r.id = m.result_id
+		WHERE r.suite_id = ?
+		ORDER BY m.result_id, m.id
+	`, suiteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query metrics: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	metrics := make(map[int64][]*aggregator.AggregatedMetric)
+
+	for rows.Next() {
+		var resultID int64
+		var m aggregator.AggregatedMetric
+		if err := rows.Scan(&resultID, &m.Name, &m.Unit, &m.HigherIsBetter, &m.Deterministic, &m.Mean, &m.Median, &m.Min, &m.Max, &m.StdDev, &m.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan metric: %w", err)
+		}
+		metrics[resultID] = append(metrics[resultID], &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating metrics: %w", err)
+	}
+
+	return metrics, nil
+}
+
 // calculateStats calculates suite statistics from results
 func calculateStats(results []*aggregator.AggregatedResult) *aggregator.SuiteStats {
 	if len(results) == 0 {
@@ -92,6 +92,351 @@ func TestSQLiteStorage_Save_NilSuite(t *testing.T) {
 	}
 }
 
+func TestSQLiteStorage_SaveSuite_ReturnsID(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "bench_test", Language: "go", Mean: 100 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Timestamp: time.Now(),
+	}
+
+	firstID, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	secondID, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	if firstID <= 0 {
+		t.Errorf("expected positive suite ID, got %d", firstID)
+	}
+	if secondID <= firstID {
+		t.Errorf("expected increasing suite IDs, got %d then %d", firstID, secondID)
+	}
+
+	if _, err := storage.SaveSuite(nil); err == nil {
+		t.Error("expected error for nil suite")
+	}
+}
+
+func TestSQLiteStorage_SaveAndLoadSamples(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	samples := []time.Duration{100 * time.Nanosecond, 120 * time.Nanosecond, 90 * time.Nanosecond}
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "bench_with_samples", Language: "go", Mean: 103 * time.Nanosecond, Samples: samples, Timestamp: time.Now()},
+			{Name: "bench_without_samples", Language: "go", Mean: 50 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Timestamp: time.Now(),
+	}
+
+	id, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	retrieved, err := storage.GetByID(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byName := make(map[string]*aggregator.AggregatedResult)
+	for _, r := range retrieved.Results {
+		byName[r.Name] = r
+	}
+
+	got := byName["bench_with_samples"].Samples
+	if len(got) != len(samples) {
+		t.Fatalf("expected %d samples, got %d", len(samples), len(got))
+	}
+	for i := range samples {
+		if got[i] != samples[i] {
+			t.Errorf("sample %d: expected %v, got %v", i, samples[i], got[i])
+		}
+	}
+
+	if byName["bench_without_samples"].Samples != nil {
+		t.Errorf("expected no samples, got %v", byName["bench_without_samples"].Samples)
+	}
+}
+
+func TestSQLiteStorage_SaveAndLoadMemoryMetrics(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	bytesPerOp, allocsPerOp, zero := int64(2048), int64(12), int64(0)
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "bench_allocating", Language: "go", Mean: 100 * time.Nanosecond, BytesPerOp: &bytesPerOp, AllocsPerOp: &allocsPerOp, Timestamp: time.Now()},
+			{Name: "bench_zero_alloc", Language: "go", Mean: 50 * time.Nanosecond, BytesPerOp: &zero, AllocsPerOp: &zero, Timestamp: time.Now()},
+			{Name: "bench_unmeasured", Language: "rust", Mean: 75 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Timestamp: time.Now(),
+	}
+
+	id, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	retrieved, err := storage.GetByID(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byName := make(map[string]*aggregator.AggregatedResult)
+	for _, r := range retrieved.Results {
+		byName[r.Name] = r
+	}
+
+	allocating := byName["bench_allocating"]
+	if allocating.BytesPerOp == nil || *allocating.BytesPerOp != 2048 {
+		t.Errorf("expected 2048 B/op, got %v", allocating.BytesPerOp)
+	}
+	if allocating.AllocsPerOp == nil || *allocating.AllocsPerOp != 12 {
+		t.Errorf("expected 12 allocs/op, got %v", allocating.AllocsPerOp)
+	}
+
+	zeroAlloc := byName["bench_zero_alloc"]
+	if zeroAlloc.AllocsPerOp == nil || *zeroAlloc.AllocsPerOp != 0 {
+		t.Errorf("expected zero allocs/op to be preserved, got %v", zeroAlloc.AllocsPerOp)
+	}
+
+	unmeasured := byName["bench_unmeasured"]
+	if unmeasured.BytesPerOp != nil || unmeasured.AllocsPerOp != nil {
+		t.Errorf("expected no memory metrics, got %v / %v", unmeasured.BytesPerOp, unmeasured.AllocsPerOp)
+	}
+
+	history, err := storage.GetHistory("bench_allocating", 10)
+	if err != nil {
+		t.Fatalf("failed to get history: %v", err)
+	}
+	if len(history) != 1 || history[0].AllocsPerOp == nil || *history[0].AllocsPerOp != 12 {
+		t.Errorf("expected history to carry allocs/op, got %+v", history)
+	}
+}
+
+func TestSQLiteStorage_SaveAndLoadMetrics(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{
+				Name: "bench_parse", Language: "rust", Mean: 100 * time.Nanosecond, Timestamp: time.Now(),
+				Metrics: []*aggregator.AggregatedMetric{
+					{Name: "instructions", Unit: "instr", Deterministic: true, Mean: 1520, Median: 1520, Min: 1520, Max: 1520, Count: 1},
+					{Name: "throughput", Unit: "MB/s", HigherIsBetter: true, Mean: 830.5, Median: 830, Min: 800, Max: 861, StdDev: 30.5, Count: 3},
+				},
+			},
+			{Name: "bench_plain", Language: "rust", Mean: 50 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Timestamp: time.Now(),
+	}
+
+	id, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	optimizer := NewQueryOptimizer(storage.db, 10)
+	for name, load := range map[string]func() (*aggregator.AggregatedSuite, error){
+		"GetByID":            func() (*aggregator.AggregatedSuite, error) { return storage.GetByID(id) },
+		"GetLatestOptimized": optimizer.GetLatestOptimized,
+	} {
+		retrieved, err := load()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		byName := make(map[string]*aggregator.AggregatedResult)
+		for _, r := range retrieved.Results {
+			byName[r.Name] = r
+		}
+
+		metrics := byName["bench_parse"].Metrics
+		if len(metrics) != 2 {
+			t.Fatalf("%s: expected 2 metrics, got %d", name, len(metrics))
+		}
+		if metrics[0].Name != "instructions" || metrics[0].Mean != 1520 || !metrics[0].Deterministic {
+			t.Errorf("%s: unexpected first metric: %+v", name, metrics[0])
+		}
+		if got := metrics[1]; !got.HigherIsBetter || got.Deterministic || got.Unit != "MB/s" || got.StdDev != 30.5 || got.Count != 3 {
+			t.Errorf("%s: unexpected throughput metric: %+v", name, got)
+		}
+		if byName["bench_plain"].Metrics != nil {
+			t.Errorf("%s: expected no metrics, got %v", name, byName["bench_plain"].Metrics)
+		}
+	}
+}
+
+func TestSQLiteStorage_SaveAndLoadEnvironment(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "BenchmarkSort-8", Language: "go", Package: "example.com/sort", Mean: 100 * time.Nanosecond, Timestamp: time.Now()},
+			{Name: "bench_plain", Language: "rust", Mean: 50 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Metadata: map[string]string{
+			"goos":    "linux",
+			"goarch":  "amd64",
+			"cpu":     "AMD EPYC 7763 64-Core Processor",
+			"package": "example.com/sort",
+		},
+		Timestamp: time.Now(),
+	}
+
+	id, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	optimizer := NewQueryOptimizer(storage.db, 10)
+	for name, load := range map[string]func() (*aggregator.AggregatedSuite, error){
+		"GetByID":            func() (*aggregator.AggregatedSuite, error) { return storage.GetByID(id) },
+		"GetLatestOptimized": optimizer.GetLatestOptimized,
+	} {
+		retrieved, err := load()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if retrieved.Metadata["cpu"] != "AMD EPYC 7763 64-Core Processor" || retrieved.Metadata["goarch"] != "amd64" {
+			t.Errorf("%s: unexpected metadata: %v", name, retrieved.Metadata)
+		}
+
+		byName := make(map[string]*aggregator.AggregatedResult)
+		for _, r := range retrieved.Results {
+			byName[r.Name] = r
+		}
+		if got := byName["BenchmarkSort-8"].Package; got != "example.com/sort" {
+			t.Errorf("%s: Package = %q, want example.com/sort", name, got)
+		}
+		if got := byName["bench_plain"].Package; got != "" {
+			t.Errorf("%s: Package = %q, want empty", name, got)
+		}
+	}
+}
+
+func TestSQLiteStorage_SaveAndLoadLabels(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{
+				Name:      "test_sort[1000-quick]",
+				Language:  "python",
+				Group:     "sorting",
+				Params:    map[string]string{"size": "1000", "algorithm": "quick"},
+				Mean:      100 * time.Nanosecond,
+				Timestamp: time.Now(),
+			},
+			{Name: "bench_plain", Language: "rust", Mean: 50 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Timestamp: time.Now(),
+	}
+
+	id, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	optimizer := NewQueryOptimizer(storage.db, 10)
+	for name, load := range map[string]func() (*aggregator.AggregatedSuite, error){
+		"GetByID":            func() (*aggregator.AggregatedSuite, error) { return storage.GetByID(id) },
+		"GetLatest":          storage.GetLatest,
+		"GetLatestOptimized": optimizer.GetLatestOptimized,
+	} {
+		retrieved, err := load()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		byName := make(map[string]*aggregator.AggregatedResult)
+		for _, r := range retrieved.Results {
+			byName[r.Name] = r
+		}
+
+		sorted := byName["test_sort[1000-quick]"]
+		if sorted.Group != "sorting" {
+			t.Errorf("%s: Group = %q, want sorting", name, sorted.Group)
+		}
+		if len(sorted.Params) != 2 || sorted.Params["size"] != "1000" || sorted.Params["algorithm"] != "quick" {
+			t.Errorf("%s: Params = %v, want size=1000 and algorithm=quick", name, sorted.Params)
+		}
+
+		plain := byName["bench_plain"]
+		if plain.Group != "" || plain.Params != nil {
+			t.Errorf("%s: expected no labels, got group %q and params %v", name, plain.Group, plain.Params)
+		}
+	}
+}
+
+func TestSQLiteStorage_Init_AddsMemoryColumns(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	// Simulate a database created before memory columns existed
+	if _, err := storage.db.Exec("ALTER TABLE results DROP COLUMN bytes_per_op"); err != nil {
+		t.Fatalf("failed to drop column: %v", err)
+	}
+
+	if err := storage.Init(); err != nil {
+		t.Fatalf("failed to re-initialize storage: %v", err)
+	}
+
+	var count int
+	err := storage.db.QueryRow("SELECT COUNT(*) FROM pragma_table_info('results') WHERE name = 'bytes_per_op'").Scan(&count)
+	if err != nil {
+		t.Fatalf("failed to inspect columns: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected bytes_per_op column to be re-added, got %d", count)
+	}
+}
+
+func TestSQLiteStorage_Cleanup_RemovesSamples(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	old := time.Now().AddDate(0, 0, -100)
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "bench_old", Language: "go", Samples: []time.Duration{1, 2, 3}, Timestamp: old},
+		},
+		Timestamp: old,
+	}
+
+	if err := storage.Save(suite); err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	if err := storage.Cleanup(90); err != nil {
+		t.Fatalf("failed to cleanup: %v", err)
+	}
+
+	for _, table := range []string{"samples", "results", "suites"} {
+		var count int
+		if err := storage.db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count); err != nil {
+			t.Fatalf("failed to count %s: %v", table, err)
+		}
+		if count != 0 {
+			t.Errorf("expected %s to be empty after cleanup, got %d rows", table, count)
+		}
+	}
+}
+
 func TestSQLiteStorage_GetLatest_Empty(t *testing.T) {
 	storage, cleanup := setupTestStorage(t)
 	defer cleanup()
@@ -160,6 +505,46 @@ func TestSQLiteStorage_GetByTimestamp_NotFound(t *testing.T) {
 	}
 }
 
+func TestSQLiteStorage_GetByID(t *testing.T) {
+	storage, cleanup := setupTestStorage(t)
+	defer cleanup()
+
+	suite := &aggregator.AggregatedSuite{
+		Results: []*aggregator.AggregatedResult{
+			{Name: "bench_by_id", Language: "go", Mean: 250 * time.Nanosecond, Timestamp: time.Now()},
+		},
+		Metadata:  map[string]string{"benchmark": "go-benchmarks"},
+		Timestamp: time.Now(),
+	}
+
+	id, err := storage.SaveSuite(suite)
+	if err != nil {
+		t.Fatalf("failed to save suite: %v", err)
+	}
+
+	retrieved, err := storage.GetByID(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if retrieved == nil {
+		t.Fatal("expected suite to be found")
+	}
+	if len(retrieved.Results) != 1 || retrieved.Results[0].Name != "bench_by_id" {
+		t.Errorf("unexpected results: %+v", retrieved.Results)
+	}
+	if retrieved.Metadata["benchmark"] != "go-benchmarks" {
+		t.Errorf("expected metadata to round-trip, got %v", retrieved.Metadata)
+	}
+
+	missing, err := storage.GetByID(id + 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if missing != nil {
+		t.Error("expected nil for unknown ID")
+	}
+}
+
 func TestSQLiteStorage_GetRange(t *testing.T) {
 	storage, cleanup := setupTestStorage(t)
 	defer cleanup()
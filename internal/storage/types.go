@@ -44,16 +44,19 @@ type StoredSuite struct {
 
 // StoredResult represents a benchmark result stored in the database
 type StoredResult struct {
-	ID         int64
-	SuiteID    int64
-	Name       string
-	Language   string
-	Mean       int64 // Duration in nanoseconds
-	Median     int64
-	Min        int64
-	Max        int64
-	StdDev     int64
-	Iterations int64
-	Timestamp  time.Time
-	CreatedAt  time.Time
+	ID          int64
+	SuiteID     int64
+	Name        string
+	Language    string
+	Package     string // Package or module the benchmark belongs to (empty when unknown)
+	Mean        int64  // Duration in nanoseconds
+	Median      int64
+	Min         int64
+	Max         int64
+	StdDev      int64
+	Iterations  int64
+	BytesPerOp  *int64 // NULL when not measured
+	AllocsPerOp *int64 // NULL when not measured
+	Timestamp   time.Time
+	CreatedAt   time.Time
 }